@@ -84,6 +84,12 @@ const (
 	// @msg=默认订阅无法被修改
 	// @code=PERMISSION_DENIED
 	Error_ERR_DEFAULT_SUBSCRIBE_UNABLE_TO_MODIFY Error = 14
+	// @msg=超出订阅配额
+	// @code=RESOURCE_EXHAUSTED
+	Error_ERR_QUOTA_EXCEEDED Error = 15
+	// @msg=订阅由清单管理，无法修改
+	// @code=PERMISSION_DENIED
+	Error_ERR_SUBSCRIBE_MANAGED Error = 16
 )
 
 // Enum value maps for Error.
@@ -104,6 +110,8 @@ var (
 		12: "ERR_TRY_TO_DELETE_DEFAULT_SUBSCRIBE",
 		13: "ERR_FORBIDDEN",
 		14: "ERR_DEFAULT_SUBSCRIBE_UNABLE_TO_MODIFY",
+		15: "ERR_QUOTA_EXCEEDED",
+		16: "ERR_SUBSCRIBE_MANAGED",
 	}
 	Error_value = map[string]int32{
 		"ERR_UNKNOWN":                            0,
@@ -121,6 +129,8 @@ var (
 		"ERR_TRY_TO_DELETE_DEFAULT_SUBSCRIBE":    12,
 		"ERR_FORBIDDEN":                          13,
 		"ERR_DEFAULT_SUBSCRIBE_UNABLE_TO_MODIFY": 14,
+		"ERR_QUOTA_EXCEEDED":                     15,
+		"ERR_SUBSCRIBE_MANAGED":                  16,
 	}
 )
 
@@ -157,7 +167,7 @@ var file_api_subscribe_v1_error_proto_rawDesc = []byte{
 	0x0a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f,
 	0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d,
 	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2a, 0x8c, 0x03,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2a, 0xbf, 0x03,
 	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x52, 0x52, 0x5f, 0x55,
 	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x52, 0x52, 0x5f,
 	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x45,
@@ -182,12 +192,16 @@ var file_api_subscribe_v1_error_proto_rawDesc = []byte{
 	0x0d, 0x45, 0x52, 0x52, 0x5f, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x0d,
 	0x12, 0x2a, 0x0a, 0x26, 0x45, 0x52, 0x52, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f,
 	0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x5f, 0x55, 0x4e, 0x41, 0x42, 0x4c, 0x45,
-	0x5f, 0x54, 0x4f, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x10, 0x0e, 0x42, 0x49, 0x0a, 0x10,
-	0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31,
-	0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
-	0x6b, 0x65, 0x65, 0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2d, 0x62, 0x72, 0x6f,
-	0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x54, 0x4f, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x10, 0x0e, 0x12, 0x16, 0x0a, 0x12,
+	0x45, 0x52, 0x52, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44,
+	0x45, 0x44, 0x10, 0x0f, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x52, 0x52, 0x5f, 0x53, 0x55, 0x42, 0x53,
+	0x43, 0x52, 0x49, 0x42, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x44, 0x10, 0x10, 0x42,
+	0x49, 0x0a, 0x10, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2d,
+	0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -26,6 +26,8 @@ var errSomeDuplicateCreate *errors.TError
 var errTryToDeleteDefaultSubscribe *errors.TError
 var errForbidden *errors.TError
 var errDefaultSubscribeUnableToModify *errors.TError
+var errQuotaExceeded *errors.TError
+var errSubscribeManaged *errors.TError
 
 func init() {
 	errUnknown = errors.New(int(codes.Unknown), "io.tkeel.rudder.api.config.v1.ERR_UNKNOWN", "未知类型")
@@ -58,6 +60,10 @@ func init() {
 	errors.Register(errForbidden)
 	errDefaultSubscribeUnableToModify = errors.New(int(codes.PermissionDenied), "io.tkeel.rudder.api.config.v1.ERR_DEFAULT_SUBSCRIBE_UNABLE_TO_MODIFY", "默认订阅无法被修改")
 	errors.Register(errDefaultSubscribeUnableToModify)
+	errQuotaExceeded = errors.New(int(codes.ResourceExhausted), "io.tkeel.rudder.api.config.v1.ERR_QUOTA_EXCEEDED", "超出订阅配额")
+	errors.Register(errQuotaExceeded)
+	errSubscribeManaged = errors.New(int(codes.PermissionDenied), "io.tkeel.rudder.api.config.v1.ERR_SUBSCRIBE_MANAGED", "订阅由清单管理，无法修改")
+	errors.Register(errSubscribeManaged)
 }
 
 func ErrUnknown() errors.Error {
@@ -119,3 +125,11 @@ func ErrForbidden() errors.Error {
 func ErrDefaultSubscribeUnableToModify() errors.Error {
 	return errDefaultSubscribeUnableToModify
 }
+
+func ErrQuotaExceeded() errors.Error {
+	return errQuotaExceeded
+}
+
+func ErrSubscribeManaged() errors.Error {
+	return errSubscribeManaged
+}
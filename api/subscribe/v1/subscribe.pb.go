@@ -82,8 +82,10 @@ type SubscribeEntitiesByIDsResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Id      uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string                `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Summary *BulkSubscribeSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Results []*EntityResult       `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
 }
 
 func (x *SubscribeEntitiesByIDsResponse) Reset() {
@@ -132,6 +134,20 @@ func (x *SubscribeEntitiesByIDsResponse) GetStatus() string {
 	return ""
 }
 
+func (x *SubscribeEntitiesByIDsResponse) GetSummary() *BulkSubscribeSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *SubscribeEntitiesByIDsResponse) GetResults() []*EntityResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type SubscribeEntitiesByGroupsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -139,6 +155,7 @@ type SubscribeEntitiesByGroupsRequest struct {
 
 	Id     uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Groups []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	DryRun bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *SubscribeEntitiesByGroupsRequest) Reset() {
@@ -187,13 +204,24 @@ func (x *SubscribeEntitiesByGroupsRequest) GetGroups() []string {
 	return nil
 }
 
+func (x *SubscribeEntitiesByGroupsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type SubscribeEntitiesByGroupsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Id      uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string                `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Summary *BulkSubscribeSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Results []*EntityResult       `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	DryRun  bool                  `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Quota   *QuotaProjection      `protobuf:"bytes,6,opt,name=quota,proto3" json:"quota,omitempty"`
 }
 
 func (x *SubscribeEntitiesByGroupsResponse) Reset() {
@@ -242,6 +270,34 @@ func (x *SubscribeEntitiesByGroupsResponse) GetStatus() string {
 	return ""
 }
 
+func (x *SubscribeEntitiesByGroupsResponse) GetSummary() *BulkSubscribeSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *SubscribeEntitiesByGroupsResponse) GetResults() []*EntityResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SubscribeEntitiesByGroupsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SubscribeEntitiesByGroupsResponse) GetQuota() *QuotaProjection {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
 type SubscribeEntitiesByModelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -249,6 +305,7 @@ type SubscribeEntitiesByModelsRequest struct {
 
 	Id     uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Models []string `protobuf:"bytes,2,rep,name=models,proto3" json:"models,omitempty"`
+	DryRun bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *SubscribeEntitiesByModelsRequest) Reset() {
@@ -297,13 +354,24 @@ func (x *SubscribeEntitiesByModelsRequest) GetModels() []string {
 	return nil
 }
 
+func (x *SubscribeEntitiesByModelsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type SubscribeEntitiesByModelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Id      uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string                `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Summary *BulkSubscribeSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Results []*EntityResult       `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	DryRun  bool                  `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Quota   *QuotaProjection      `protobuf:"bytes,6,opt,name=quota,proto3" json:"quota,omitempty"`
 }
 
 func (x *SubscribeEntitiesByModelsResponse) Reset() {
@@ -352,17 +420,48 @@ func (x *SubscribeEntitiesByModelsResponse) GetStatus() string {
 	return ""
 }
 
-type UnsubscribeEntitiesByIDsRequest struct {
+func (x *SubscribeEntitiesByModelsResponse) GetSummary() *BulkSubscribeSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *SubscribeEntitiesByModelsResponse) GetResults() []*EntityResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SubscribeEntitiesByModelsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SubscribeEntitiesByModelsResponse) GetQuota() *QuotaProjection {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type QuotaProjection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Entities []string `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	SubscribeEntities      int64 `protobuf:"varint,1,opt,name=subscribe_entities,json=subscribeEntities,proto3" json:"subscribe_entities,omitempty"`
+	SubscribeEntitiesLimit int64 `protobuf:"varint,2,opt,name=subscribe_entities_limit,json=subscribeEntitiesLimit,proto3" json:"subscribe_entities_limit,omitempty"`
+	TenantEntities         int64 `protobuf:"varint,3,opt,name=tenant_entities,json=tenantEntities,proto3" json:"tenant_entities,omitempty"`
+	TenantEntitiesLimit    int64 `protobuf:"varint,4,opt,name=tenant_entities_limit,json=tenantEntitiesLimit,proto3" json:"tenant_entities_limit,omitempty"`
+	Exceeded               bool  `protobuf:"varint,5,opt,name=exceeded,proto3" json:"exceeded,omitempty"`
 }
 
-func (x *UnsubscribeEntitiesByIDsRequest) Reset() {
-	*x = UnsubscribeEntitiesByIDsRequest{}
+func (x *QuotaProjection) Reset() {
+	*x = QuotaProjection{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -370,13 +469,13 @@ func (x *UnsubscribeEntitiesByIDsRequest) Reset() {
 	}
 }
 
-func (x *UnsubscribeEntitiesByIDsRequest) String() string {
+func (x *QuotaProjection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubscribeEntitiesByIDsRequest) ProtoMessage() {}
+func (*QuotaProjection) ProtoMessage() {}
 
-func (x *UnsubscribeEntitiesByIDsRequest) ProtoReflect() protoreflect.Message {
+func (x *QuotaProjection) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -388,36 +487,59 @@ func (x *UnsubscribeEntitiesByIDsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubscribeEntitiesByIDsRequest.ProtoReflect.Descriptor instead.
-func (*UnsubscribeEntitiesByIDsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuotaProjection.ProtoReflect.Descriptor instead.
+func (*QuotaProjection) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UnsubscribeEntitiesByIDsRequest) GetId() uint64 {
+func (x *QuotaProjection) GetSubscribeEntities() int64 {
 	if x != nil {
-		return x.Id
+		return x.SubscribeEntities
 	}
 	return 0
 }
 
-func (x *UnsubscribeEntitiesByIDsRequest) GetEntities() []string {
+func (x *QuotaProjection) GetSubscribeEntitiesLimit() int64 {
 	if x != nil {
-		return x.Entities
+		return x.SubscribeEntitiesLimit
 	}
-	return nil
+	return 0
 }
 
-type UnsubscribeEntitiesByIDsResponse struct {
+func (x *QuotaProjection) GetTenantEntities() int64 {
+	if x != nil {
+		return x.TenantEntities
+	}
+	return 0
+}
+
+func (x *QuotaProjection) GetTenantEntitiesLimit() int64 {
+	if x != nil {
+		return x.TenantEntitiesLimit
+	}
+	return 0
+}
+
+func (x *QuotaProjection) GetExceeded() bool {
+	if x != nil {
+		return x.Exceeded
+	}
+	return false
+}
+
+type SubscribeEntitiesByQueryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Id         uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Conditions []*SelectorCondition `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Keywords   string               `protobuf:"bytes,3,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	DryRun     bool                 `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *UnsubscribeEntitiesByIDsResponse) Reset() {
-	*x = UnsubscribeEntitiesByIDsResponse{}
+func (x *SubscribeEntitiesByQueryRequest) Reset() {
+	*x = SubscribeEntitiesByQueryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -425,13 +547,13 @@ func (x *UnsubscribeEntitiesByIDsResponse) Reset() {
 	}
 }
 
-func (x *UnsubscribeEntitiesByIDsResponse) String() string {
+func (x *SubscribeEntitiesByQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubscribeEntitiesByIDsResponse) ProtoMessage() {}
+func (*SubscribeEntitiesByQueryRequest) ProtoMessage() {}
 
-func (x *UnsubscribeEntitiesByIDsResponse) ProtoReflect() protoreflect.Message {
+func (x *SubscribeEntitiesByQueryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -443,41 +565,51 @@ func (x *UnsubscribeEntitiesByIDsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubscribeEntitiesByIDsResponse.ProtoReflect.Descriptor instead.
-func (*UnsubscribeEntitiesByIDsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeEntitiesByQueryRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEntitiesByQueryRequest) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UnsubscribeEntitiesByIDsResponse) GetId() uint64 {
+func (x *SubscribeEntitiesByQueryRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UnsubscribeEntitiesByIDsResponse) GetStatus() string {
+func (x *SubscribeEntitiesByQueryRequest) GetConditions() []*SelectorCondition {
 	if x != nil {
-		return x.Status
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *SubscribeEntitiesByQueryRequest) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
 	}
 	return ""
 }
 
-type ListSubscribeEntitiesRequest struct {
+func (x *SubscribeEntitiesByQueryRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type QueriedDevice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageNum      uint64 `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	PageSize     uint64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	OrderBy      string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	IsDescending bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
-	KeyWords     string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
-	SearchKey    string `protobuf:"bytes,6,opt,name=search_key,json=searchKey,proto3" json:"search_key,omitempty"`
-	Id           uint64 `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Online bool   `protobuf:"varint,3,opt,name=online,proto3" json:"online,omitempty"`
 }
 
-func (x *ListSubscribeEntitiesRequest) Reset() {
-	*x = ListSubscribeEntitiesRequest{}
+func (x *QueriedDevice) Reset() {
+	*x = QueriedDevice{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -485,13 +617,13 @@ func (x *ListSubscribeEntitiesRequest) Reset() {
 	}
 }
 
-func (x *ListSubscribeEntitiesRequest) String() string {
+func (x *QueriedDevice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSubscribeEntitiesRequest) ProtoMessage() {}
+func (*QueriedDevice) ProtoMessage() {}
 
-func (x *ListSubscribeEntitiesRequest) ProtoReflect() protoreflect.Message {
+func (x *QueriedDevice) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -503,74 +635,48 @@ func (x *ListSubscribeEntitiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSubscribeEntitiesRequest.ProtoReflect.Descriptor instead.
-func (*ListSubscribeEntitiesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueriedDevice.ProtoReflect.Descriptor instead.
+func (*QueriedDevice) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListSubscribeEntitiesRequest) GetPageNum() uint64 {
-	if x != nil {
-		return x.PageNum
-	}
-	return 0
-}
-
-func (x *ListSubscribeEntitiesRequest) GetPageSize() uint64 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListSubscribeEntitiesRequest) GetOrderBy() string {
-	if x != nil {
-		return x.OrderBy
-	}
-	return ""
-}
-
-func (x *ListSubscribeEntitiesRequest) GetIsDescending() bool {
-	if x != nil {
-		return x.IsDescending
-	}
-	return false
-}
-
-func (x *ListSubscribeEntitiesRequest) GetKeyWords() string {
+func (x *QueriedDevice) GetId() string {
 	if x != nil {
-		return x.KeyWords
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListSubscribeEntitiesRequest) GetSearchKey() string {
+func (x *QueriedDevice) GetName() string {
 	if x != nil {
-		return x.SearchKey
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListSubscribeEntitiesRequest) GetId() uint64 {
+func (x *QueriedDevice) GetOnline() bool {
 	if x != nil {
-		return x.Id
+		return x.Online
 	}
-	return 0
+	return false
 }
 
-type ListSubscribeEntitiesResponse struct {
+type SubscribeEntitiesByQueryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total    uint64    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	PageNum  uint64    `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	LastPage uint64    `protobuf:"varint,3,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
-	PageSize uint64    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Data     []*Entity `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	Id      uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string                `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Summary *BulkSubscribeSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Results []*EntityResult       `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	Devices []*QueriedDevice      `protobuf:"bytes,5,rep,name=devices,proto3" json:"devices,omitempty"`
+	DryRun  bool                  `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Quota   *QuotaProjection      `protobuf:"bytes,7,opt,name=quota,proto3" json:"quota,omitempty"`
 }
 
-func (x *ListSubscribeEntitiesResponse) Reset() {
-	*x = ListSubscribeEntitiesResponse{}
+func (x *SubscribeEntitiesByQueryResponse) Reset() {
+	*x = SubscribeEntitiesByQueryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -578,13 +684,13 @@ func (x *ListSubscribeEntitiesResponse) Reset() {
 	}
 }
 
-func (x *ListSubscribeEntitiesResponse) String() string {
+func (x *SubscribeEntitiesByQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSubscribeEntitiesResponse) ProtoMessage() {}
+func (*SubscribeEntitiesByQueryResponse) ProtoMessage() {}
 
-func (x *ListSubscribeEntitiesResponse) ProtoReflect() protoreflect.Message {
+func (x *SubscribeEntitiesByQueryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -596,60 +702,72 @@ func (x *ListSubscribeEntitiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSubscribeEntitiesResponse.ProtoReflect.Descriptor instead.
-func (*ListSubscribeEntitiesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeEntitiesByQueryResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeEntitiesByQueryResponse) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ListSubscribeEntitiesResponse) GetTotal() uint64 {
+func (x *SubscribeEntitiesByQueryResponse) GetId() uint64 {
 	if x != nil {
-		return x.Total
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListSubscribeEntitiesResponse) GetPageNum() uint64 {
+func (x *SubscribeEntitiesByQueryResponse) GetStatus() string {
 	if x != nil {
-		return x.PageNum
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *ListSubscribeEntitiesResponse) GetLastPage() uint64 {
+func (x *SubscribeEntitiesByQueryResponse) GetSummary() *BulkSubscribeSummary {
 	if x != nil {
-		return x.LastPage
+		return x.Summary
 	}
-	return 0
+	return nil
 }
 
-func (x *ListSubscribeEntitiesResponse) GetPageSize() uint64 {
+func (x *SubscribeEntitiesByQueryResponse) GetResults() []*EntityResult {
 	if x != nil {
-		return x.PageSize
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
-func (x *ListSubscribeEntitiesResponse) GetData() []*Entity {
+func (x *SubscribeEntitiesByQueryResponse) GetDevices() []*QueriedDevice {
 	if x != nil {
-		return x.Data
+		return x.Devices
 	}
 	return nil
 }
 
-type SubscribeObject struct {
+func (x *SubscribeEntitiesByQueryResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SubscribeEntitiesByQueryResponse) GetQuota() *QuotaProjection {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type UnsubscribeEntitiesByIDsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	IsDefault   bool   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Id       uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Entities []string `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	DryRun   bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *SubscribeObject) Reset() {
-	*x = SubscribeObject{}
+func (x *UnsubscribeEntitiesByIDsRequest) Reset() {
+	*x = UnsubscribeEntitiesByIDsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -657,13 +775,13 @@ func (x *SubscribeObject) Reset() {
 	}
 }
 
-func (x *SubscribeObject) String() string {
+func (x *UnsubscribeEntitiesByIDsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeObject) ProtoMessage() {}
+func (*UnsubscribeEntitiesByIDsRequest) ProtoMessage() {}
 
-func (x *SubscribeObject) ProtoReflect() protoreflect.Message {
+func (x *UnsubscribeEntitiesByIDsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -675,57 +793,46 @@ func (x *SubscribeObject) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeObject.ProtoReflect.Descriptor instead.
-func (*SubscribeObject) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubscribeEntitiesByIDsRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeEntitiesByIDsRequest) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SubscribeObject) GetId() uint64 {
+func (x *UnsubscribeEntitiesByIDsRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *SubscribeObject) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *SubscribeObject) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *SubscribeObject) GetEndpoint() string {
+func (x *UnsubscribeEntitiesByIDsRequest) GetEntities() []string {
 	if x != nil {
-		return x.Endpoint
+		return x.Entities
 	}
-	return ""
+	return nil
 }
 
-func (x *SubscribeObject) GetIsDefault() bool {
+func (x *UnsubscribeEntitiesByIDsRequest) GetDryRun() bool {
 	if x != nil {
-		return x.IsDefault
+		return x.DryRun
 	}
 	return false
 }
 
-type CreateSubscribeRequest struct {
+type UnsubscribeEntitiesByIDsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Id      uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string                  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Summary *BulkUnsubscribeSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Results []*EntityResult         `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	DryRun  bool                    `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *CreateSubscribeRequest) Reset() {
-	*x = CreateSubscribeRequest{}
+func (x *UnsubscribeEntitiesByIDsResponse) Reset() {
+	*x = UnsubscribeEntitiesByIDsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -733,13 +840,13 @@ func (x *CreateSubscribeRequest) Reset() {
 	}
 }
 
-func (x *CreateSubscribeRequest) String() string {
+func (x *UnsubscribeEntitiesByIDsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSubscribeRequest) ProtoMessage() {}
+func (*UnsubscribeEntitiesByIDsResponse) ProtoMessage() {}
 
-func (x *CreateSubscribeRequest) ProtoReflect() protoreflect.Message {
+func (x *UnsubscribeEntitiesByIDsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -751,39 +858,57 @@ func (x *CreateSubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSubscribeRequest.ProtoReflect.Descriptor instead.
-func (*CreateSubscribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubscribeEntitiesByIDsResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeEntitiesByIDsResponse) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateSubscribeRequest) GetTitle() string {
+func (x *UnsubscribeEntitiesByIDsResponse) GetId() uint64 {
 	if x != nil {
-		return x.Title
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateSubscribeRequest) GetDescription() string {
+func (x *UnsubscribeEntitiesByIDsResponse) GetStatus() string {
 	if x != nil {
-		return x.Description
+		return x.Status
 	}
 	return ""
 }
 
-type CreateSubscribeResponse struct {
+func (x *UnsubscribeEntitiesByIDsResponse) GetSummary() *BulkUnsubscribeSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *UnsubscribeEntitiesByIDsResponse) GetResults() []*EntityResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *UnsubscribeEntitiesByIDsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type UnsubscribeEntitiesByGroupsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	IsDefault   bool   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Id     uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Groups []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-func (x *CreateSubscribeResponse) Reset() {
-	*x = CreateSubscribeResponse{}
+func (x *UnsubscribeEntitiesByGroupsRequest) Reset() {
+	*x = UnsubscribeEntitiesByGroupsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -791,13 +916,13 @@ func (x *CreateSubscribeResponse) Reset() {
 	}
 }
 
-func (x *CreateSubscribeResponse) String() string {
+func (x *UnsubscribeEntitiesByGroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSubscribeResponse) ProtoMessage() {}
+func (*UnsubscribeEntitiesByGroupsRequest) ProtoMessage() {}
 
-func (x *CreateSubscribeResponse) ProtoReflect() protoreflect.Message {
+func (x *UnsubscribeEntitiesByGroupsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -809,58 +934,38 @@ func (x *CreateSubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSubscribeResponse.ProtoReflect.Descriptor instead.
-func (*CreateSubscribeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubscribeEntitiesByGroupsRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeEntitiesByGroupsRequest) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateSubscribeResponse) GetId() uint64 {
+func (x *UnsubscribeEntitiesByGroupsRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *CreateSubscribeResponse) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *CreateSubscribeResponse) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *CreateSubscribeResponse) GetEndpoint() string {
-	if x != nil {
-		return x.Endpoint
-	}
-	return ""
-}
-
-func (x *CreateSubscribeResponse) GetIsDefault() bool {
+func (x *UnsubscribeEntitiesByGroupsRequest) GetGroups() []string {
 	if x != nil {
-		return x.IsDefault
+		return x.Groups
 	}
-	return false
+	return nil
 }
 
-type UpdateSubscribeRequest struct {
+type UnsubscribeEntitiesByGroupsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Id      uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string                  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Summary *BulkUnsubscribeSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Results []*EntityResult         `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *UpdateSubscribeRequest) Reset() {
-	*x = UpdateSubscribeRequest{}
+func (x *UnsubscribeEntitiesByGroupsResponse) Reset() {
+	*x = UnsubscribeEntitiesByGroupsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -868,13 +973,13 @@ func (x *UpdateSubscribeRequest) Reset() {
 	}
 }
 
-func (x *UpdateSubscribeRequest) String() string {
+func (x *UnsubscribeEntitiesByGroupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSubscribeRequest) ProtoMessage() {}
+func (*UnsubscribeEntitiesByGroupsResponse) ProtoMessage() {}
 
-func (x *UpdateSubscribeRequest) ProtoReflect() protoreflect.Message {
+func (x *UnsubscribeEntitiesByGroupsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -886,46 +991,50 @@ func (x *UpdateSubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSubscribeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSubscribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubscribeEntitiesByGroupsResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeEntitiesByGroupsResponse) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UpdateSubscribeRequest) GetTitle() string {
+func (x *UnsubscribeEntitiesByGroupsResponse) GetId() uint64 {
 	if x != nil {
-		return x.Title
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateSubscribeRequest) GetDescription() string {
+func (x *UnsubscribeEntitiesByGroupsResponse) GetStatus() string {
 	if x != nil {
-		return x.Description
+		return x.Status
 	}
 	return ""
 }
 
-func (x *UpdateSubscribeRequest) GetId() uint64 {
+func (x *UnsubscribeEntitiesByGroupsResponse) GetSummary() *BulkUnsubscribeSummary {
 	if x != nil {
-		return x.Id
+		return x.Summary
 	}
-	return 0
+	return nil
 }
 
-type UpdateSubscribeResponse struct {
+func (x *UnsubscribeEntitiesByGroupsResponse) GetResults() []*EntityResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type UnsubscribeEntitiesByModelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	IsDefault   bool   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Id     uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Models []string `protobuf:"bytes,2,rep,name=models,proto3" json:"models,omitempty"`
 }
 
-func (x *UpdateSubscribeResponse) Reset() {
-	*x = UpdateSubscribeResponse{}
+func (x *UnsubscribeEntitiesByModelsRequest) Reset() {
+	*x = UnsubscribeEntitiesByModelsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -933,13 +1042,13 @@ func (x *UpdateSubscribeResponse) Reset() {
 	}
 }
 
-func (x *UpdateSubscribeResponse) String() string {
+func (x *UnsubscribeEntitiesByModelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSubscribeResponse) ProtoMessage() {}
+func (*UnsubscribeEntitiesByModelsRequest) ProtoMessage() {}
 
-func (x *UpdateSubscribeResponse) ProtoReflect() protoreflect.Message {
+func (x *UnsubscribeEntitiesByModelsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -951,56 +1060,38 @@ func (x *UpdateSubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSubscribeResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSubscribeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubscribeEntitiesByModelsRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeEntitiesByModelsRequest) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UpdateSubscribeResponse) GetId() uint64 {
+func (x *UnsubscribeEntitiesByModelsRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateSubscribeResponse) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *UpdateSubscribeResponse) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *UpdateSubscribeResponse) GetEndpoint() string {
+func (x *UnsubscribeEntitiesByModelsRequest) GetModels() []string {
 	if x != nil {
-		return x.Endpoint
-	}
-	return ""
-}
-
-func (x *UpdateSubscribeResponse) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+		return x.Models
 	}
-	return false
+	return nil
 }
 
-type DeleteSubscribeRequest struct {
+type UnsubscribeEntitiesByModelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id      uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string                  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Summary *BulkUnsubscribeSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Results []*EntityResult         `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *DeleteSubscribeRequest) Reset() {
-	*x = DeleteSubscribeRequest{}
+func (x *UnsubscribeEntitiesByModelsResponse) Reset() {
+	*x = UnsubscribeEntitiesByModelsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1008,13 +1099,13 @@ func (x *DeleteSubscribeRequest) Reset() {
 	}
 }
 
-func (x *DeleteSubscribeRequest) String() string {
+func (x *UnsubscribeEntitiesByModelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSubscribeRequest) ProtoMessage() {}
+func (*UnsubscribeEntitiesByModelsResponse) ProtoMessage() {}
 
-func (x *DeleteSubscribeRequest) ProtoReflect() protoreflect.Message {
+func (x *UnsubscribeEntitiesByModelsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1026,28 +1117,57 @@ func (x *DeleteSubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSubscribeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSubscribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubscribeEntitiesByModelsResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeEntitiesByModelsResponse) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DeleteSubscribeRequest) GetId() uint64 {
+func (x *UnsubscribeEntitiesByModelsResponse) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeleteSubscribeResponse struct {
+func (x *UnsubscribeEntitiesByModelsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UnsubscribeEntitiesByModelsResponse) GetSummary() *BulkUnsubscribeSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *UnsubscribeEntitiesByModelsResponse) GetResults() []*EntityResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ListSubscribeEntitiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PageNum      uint64 `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize     uint64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrderBy      string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	IsDescending bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
+	KeyWords     string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
+	SearchKey    string `protobuf:"bytes,6,opt,name=search_key,json=searchKey,proto3" json:"search_key,omitempty"`
+	Id           uint64 `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	Status       string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	PageToken    string `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *DeleteSubscribeResponse) Reset() {
-	*x = DeleteSubscribeResponse{}
+func (x *ListSubscribeEntitiesRequest) Reset() {
+	*x = ListSubscribeEntitiesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1055,13 +1175,13 @@ func (x *DeleteSubscribeResponse) Reset() {
 	}
 }
 
-func (x *DeleteSubscribeResponse) String() string {
+func (x *ListSubscribeEntitiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSubscribeResponse) ProtoMessage() {}
+func (*ListSubscribeEntitiesRequest) ProtoMessage() {}
 
-func (x *DeleteSubscribeResponse) ProtoReflect() protoreflect.Message {
+func (x *ListSubscribeEntitiesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1073,97 +1193,104 @@ func (x *DeleteSubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSubscribeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSubscribeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSubscribeEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscribeEntitiesRequest) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DeleteSubscribeResponse) GetId() uint64 {
+func (x *ListSubscribeEntitiesRequest) GetPageNum() uint64 {
 	if x != nil {
-		return x.Id
+		return x.PageNum
 	}
 	return 0
 }
 
-type GetSubscribeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *ListSubscribeEntitiesRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *GetSubscribeRequest) Reset() {
-	*x = GetSubscribeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListSubscribeEntitiesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
 	}
+	return ""
 }
 
-func (x *GetSubscribeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListSubscribeEntitiesRequest) GetIsDescending() bool {
+	if x != nil {
+		return x.IsDescending
+	}
+	return false
 }
 
-func (*GetSubscribeRequest) ProtoMessage() {}
-
-func (x *GetSubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListSubscribeEntitiesRequest) GetKeyWords() string {
+	if x != nil {
+		return x.KeyWords
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetSubscribeRequest.ProtoReflect.Descriptor instead.
-func (*GetSubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{17}
+func (x *ListSubscribeEntitiesRequest) GetSearchKey() string {
+	if x != nil {
+		return x.SearchKey
+	}
+	return ""
 }
 
-func (x *GetSubscribeRequest) GetId() uint64 {
+func (x *ListSubscribeEntitiesRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetSubscribeResponse struct {
-	state         protoimpl.MessageState
+func (x *ListSubscribeEntitiesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListSubscribeEntitiesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSubscribeEntitiesResponse struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Count       uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
-	CreatedAt   int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   int64  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	IsDefault   bool   `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Total         uint64    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageNum       uint64    `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	LastPage      uint64    `protobuf:"varint,3,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
+	PageSize      uint64    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Data          []*Entity `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	NextPageToken string    `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *GetSubscribeResponse) Reset() {
-	*x = GetSubscribeResponse{}
+func (x *ListSubscribeEntitiesResponse) Reset() {
+	*x = ListSubscribeEntitiesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[18]
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSubscribeResponse) String() string {
+func (x *ListSubscribeEntitiesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubscribeResponse) ProtoMessage() {}
+func (*ListSubscribeEntitiesResponse) ProtoMessage() {}
 
-func (x *GetSubscribeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[18]
+func (x *ListSubscribeEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1174,97 +1301,84 @@ func (x *GetSubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubscribeResponse.ProtoReflect.Descriptor instead.
-func (*GetSubscribeResponse) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListSubscribeEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscribeEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetSubscribeResponse) GetId() uint64 {
+func (x *ListSubscribeEntitiesResponse) GetTotal() uint64 {
 	if x != nil {
-		return x.Id
+		return x.Total
 	}
 	return 0
 }
 
-func (x *GetSubscribeResponse) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *GetSubscribeResponse) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *GetSubscribeResponse) GetEndpoint() string {
+func (x *ListSubscribeEntitiesResponse) GetPageNum() uint64 {
 	if x != nil {
-		return x.Endpoint
+		return x.PageNum
 	}
-	return ""
+	return 0
 }
 
-func (x *GetSubscribeResponse) GetCount() uint64 {
+func (x *ListSubscribeEntitiesResponse) GetLastPage() uint64 {
 	if x != nil {
-		return x.Count
+		return x.LastPage
 	}
 	return 0
 }
 
-func (x *GetSubscribeResponse) GetCreatedAt() int64 {
+func (x *ListSubscribeEntitiesResponse) GetPageSize() uint64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *GetSubscribeResponse) GetUpdatedAt() int64 {
+func (x *ListSubscribeEntitiesResponse) GetData() []*Entity {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *GetSubscribeResponse) GetIsDefault() bool {
+func (x *ListSubscribeEntitiesResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.IsDefault
+		return x.NextPageToken
 	}
-	return false
+	return ""
 }
 
-type ListSubscribeRequest struct {
+type SubscribeObject struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageNum      uint64 `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	PageSize     uint64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	OrderBy      string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	IsDescending bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
-	KeyWords     string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
-	SearchKey    string `protobuf:"bytes,6,opt,name=search_key,json=searchKey,proto3" json:"search_key,omitempty"`
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	IsDefault   bool   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Managed     bool   `protobuf:"varint,6,opt,name=managed,proto3" json:"managed,omitempty"`
+	Status      string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *ListSubscribeRequest) Reset() {
-	*x = ListSubscribeRequest{}
+func (x *SubscribeObject) Reset() {
+	*x = SubscribeObject{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[19]
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSubscribeRequest) String() string {
+func (x *SubscribeObject) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSubscribeRequest) ProtoMessage() {}
+func (*SubscribeObject) ProtoMessage() {}
 
-func (x *ListSubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[19]
+func (x *SubscribeObject) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1275,82 +1389,86 @@ func (x *ListSubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSubscribeRequest.ProtoReflect.Descriptor instead.
-func (*ListSubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SubscribeObject.ProtoReflect.Descriptor instead.
+func (*SubscribeObject) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListSubscribeRequest) GetPageNum() uint64 {
+func (x *SubscribeObject) GetId() uint64 {
 	if x != nil {
-		return x.PageNum
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListSubscribeRequest) GetPageSize() uint64 {
+func (x *SubscribeObject) GetTitle() string {
 	if x != nil {
-		return x.PageSize
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *ListSubscribeRequest) GetOrderBy() string {
+func (x *SubscribeObject) GetDescription() string {
 	if x != nil {
-		return x.OrderBy
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ListSubscribeRequest) GetIsDescending() bool {
+func (x *SubscribeObject) GetEndpoint() string {
 	if x != nil {
-		return x.IsDescending
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *SubscribeObject) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
 	}
 	return false
 }
 
-func (x *ListSubscribeRequest) GetKeyWords() string {
+func (x *SubscribeObject) GetManaged() bool {
 	if x != nil {
-		return x.KeyWords
+		return x.Managed
 	}
-	return ""
+	return false
 }
 
-func (x *ListSubscribeRequest) GetSearchKey() string {
+func (x *SubscribeObject) GetStatus() string {
 	if x != nil {
-		return x.SearchKey
+		return x.Status
 	}
 	return ""
 }
 
-type ListSubscribeResponse struct {
+type CreateSubscribeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total    uint64             `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	PageNum  uint64             `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	LastPage uint64             `protobuf:"varint,3,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
-	PageSize uint64             `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Data     []*SubscribeObject `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *ListSubscribeResponse) Reset() {
-	*x = ListSubscribeResponse{}
+func (x *CreateSubscribeRequest) Reset() {
+	*x = CreateSubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[20]
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSubscribeResponse) String() string {
+func (x *CreateSubscribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSubscribeResponse) ProtoMessage() {}
+func (*CreateSubscribeRequest) ProtoMessage() {}
 
-func (x *ListSubscribeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[20]
+func (x *CreateSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1361,73 +1479,54 @@ func (x *ListSubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSubscribeResponse.ProtoReflect.Descriptor instead.
-func (*ListSubscribeResponse) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *ListSubscribeResponse) GetTotal() uint64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListSubscribeResponse) GetPageNum() uint64 {
-	if x != nil {
-		return x.PageNum
-	}
-	return 0
-}
-
-func (x *ListSubscribeResponse) GetLastPage() uint64 {
-	if x != nil {
-		return x.LastPage
-	}
-	return 0
+// Deprecated: Use CreateSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListSubscribeResponse) GetPageSize() uint64 {
+func (x *CreateSubscribeRequest) GetTitle() string {
 	if x != nil {
-		return x.PageSize
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *ListSubscribeResponse) GetData() []*SubscribeObject {
+func (x *CreateSubscribeRequest) GetDescription() string {
 	if x != nil {
-		return x.Data
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-type ChangeSubscribedRequest struct {
+type CreateSubscribeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	TargetId    uint64   `protobuf:"varint,2,opt,name=targetId,proto3" json:"targetId,omitempty"`
-	SelectedIds []string `protobuf:"bytes,3,rep,name=selectedIds,proto3" json:"selectedIds,omitempty"`
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	IsDefault   bool   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
 }
 
-func (x *ChangeSubscribedRequest) Reset() {
-	*x = ChangeSubscribedRequest{}
+func (x *CreateSubscribeResponse) Reset() {
+	*x = CreateSubscribeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[21]
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeSubscribedRequest) String() string {
+func (x *CreateSubscribeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeSubscribedRequest) ProtoMessage() {}
+func (*CreateSubscribeResponse) ProtoMessage() {}
 
-func (x *ChangeSubscribedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[21]
+func (x *CreateSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1438,57 +1537,73 @@ func (x *ChangeSubscribedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeSubscribedRequest.ProtoReflect.Descriptor instead.
-func (*ChangeSubscribedRequest) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use CreateSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ChangeSubscribedRequest) GetId() uint64 {
+func (x *CreateSubscribeResponse) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ChangeSubscribedRequest) GetTargetId() uint64 {
+func (x *CreateSubscribeResponse) GetTitle() string {
 	if x != nil {
-		return x.TargetId
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangeSubscribedRequest) GetSelectedIds() []string {
+func (x *CreateSubscribeResponse) GetDescription() string {
 	if x != nil {
-		return x.SelectedIds
+		return x.Description
 	}
-	return nil
-}
-
-type ChangeSubscribedResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	return ""
 }
 
-func (x *ChangeSubscribedResponse) Reset() {
-	*x = ChangeSubscribedResponse{}
+func (x *CreateSubscribeResponse) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *CreateSubscribeResponse) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type UpdateSubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Id          uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UpdateSubscribeRequest) Reset() {
+	*x = UpdateSubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[22]
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeSubscribedResponse) String() string {
+func (x *UpdateSubscribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeSubscribedResponse) ProtoMessage() {}
+func (*UpdateSubscribeRequest) ProtoMessage() {}
 
-func (x *ChangeSubscribedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[22]
+func (x *UpdateSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1499,48 +1614,61 @@ func (x *ChangeSubscribedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeSubscribedResponse.ProtoReflect.Descriptor instead.
-func (*ChangeSubscribedResponse) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdateSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ChangeSubscribedResponse) GetStatus() string {
+func (x *UpdateSubscribeRequest) GetTitle() string {
 	if x != nil {
-		return x.Status
+		return x.Title
 	}
 	return ""
 }
 
-type Entity struct {
+func (x *UpdateSubscribeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSubscribeRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateSubscribeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Status    string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Template  string `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
-	Group     string `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
-	UpdatedAt int64  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	IsDefault   bool   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
 }
 
-func (x *Entity) Reset() {
-	*x = Entity{}
+func (x *UpdateSubscribeResponse) Reset() {
+	*x = UpdateSubscribeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[23]
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Entity) String() string {
+func (x *UpdateSubscribeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Entity) ProtoMessage() {}
+func (*UpdateSubscribeResponse) ProtoMessage() {}
 
-func (x *Entity) ProtoReflect() protoreflect.Message {
-	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[23]
+func (x *UpdateSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,63 +1679,103 @@ func (x *Entity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
-func (*Entity) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UpdateSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Entity) GetID() string {
+func (x *UpdateSubscribeResponse) GetId() uint64 {
 	if x != nil {
-		return x.ID
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *Entity) GetName() string {
+func (x *UpdateSubscribeResponse) GetTitle() string {
 	if x != nil {
-		return x.Name
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Entity) GetStatus() string {
+func (x *UpdateSubscribeResponse) GetDescription() string {
 	if x != nil {
-		return x.Status
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Entity) GetTemplate() string {
+func (x *UpdateSubscribeResponse) GetEndpoint() string {
 	if x != nil {
-		return x.Template
+		return x.Endpoint
 	}
 	return ""
 }
 
-func (x *Entity) GetGroup() string {
+func (x *UpdateSubscribeResponse) GetIsDefault() bool {
 	if x != nil {
-		return x.Group
+		return x.IsDefault
 	}
-	return ""
+	return false
 }
 
-func (x *Entity) GetUpdatedAt() int64 {
+type DeleteSubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteSubscribeRequest) Reset() {
+	*x = DeleteSubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscribeRequest) ProtoMessage() {}
+
+func (x *DeleteSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteSubscribeRequest) GetId() uint64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Id
 	}
 	return 0
 }
 
-type ValidateSubscribedRequest struct {
+type DeleteSubscribeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ValidateSubscribedRequest) Reset() {
-	*x = ValidateSubscribedRequest{}
+func (x *DeleteSubscribeResponse) Reset() {
+	*x = DeleteSubscribeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1615,13 +1783,13 @@ func (x *ValidateSubscribedRequest) Reset() {
 	}
 }
 
-func (x *ValidateSubscribedRequest) String() string {
+func (x *DeleteSubscribeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateSubscribedRequest) ProtoMessage() {}
+func (*DeleteSubscribeResponse) ProtoMessage() {}
 
-func (x *ValidateSubscribedRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteSubscribeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1633,28 +1801,28 @@ func (x *ValidateSubscribedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateSubscribedRequest.ProtoReflect.Descriptor instead.
-func (*ValidateSubscribedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubscribeResponse) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ValidateSubscribedRequest) GetTopic() string {
+func (x *DeleteSubscribeResponse) GetId() uint64 {
 	if x != nil {
-		return x.Topic
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type ValidateSubscribedResponse struct {
+type GetSubscribeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ValidateSubscribedResponse) Reset() {
-	*x = ValidateSubscribedResponse{}
+func (x *GetSubscribeRequest) Reset() {
+	*x = GetSubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1662,13 +1830,13 @@ func (x *ValidateSubscribedResponse) Reset() {
 	}
 }
 
-func (x *ValidateSubscribedResponse) String() string {
+func (x *GetSubscribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateSubscribedResponse) ProtoMessage() {}
+func (*GetSubscribeRequest) ProtoMessage() {}
 
-func (x *ValidateSubscribedResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSubscribeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1680,29 +1848,37 @@ func (x *ValidateSubscribedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateSubscribedResponse.ProtoReflect.Descriptor instead.
-func (*ValidateSubscribedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscribeRequest) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ValidateSubscribedResponse) GetStatus() string {
+func (x *GetSubscribeRequest) GetId() uint64 {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type SubscribeByDeviceRequest struct {
+type GetSubscribeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SubscribeIds []string `protobuf:"bytes,2,rep,name=subscribe_ids,json=subscribeIds,proto3" json:"subscribe_ids,omitempty"`
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Count       uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	CreatedAt   int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   int64  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsDefault   bool   `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Managed     bool   `protobuf:"varint,9,opt,name=managed,proto3" json:"managed,omitempty"`
+	Status      string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *SubscribeByDeviceRequest) Reset() {
-	*x = SubscribeByDeviceRequest{}
+func (x *GetSubscribeResponse) Reset() {
+	*x = GetSubscribeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1710,13 +1886,13 @@ func (x *SubscribeByDeviceRequest) Reset() {
 	}
 }
 
-func (x *SubscribeByDeviceRequest) String() string {
+func (x *GetSubscribeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeByDeviceRequest) ProtoMessage() {}
+func (*GetSubscribeResponse) ProtoMessage() {}
 
-func (x *SubscribeByDeviceRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSubscribeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1728,35 +1904,97 @@ func (x *SubscribeByDeviceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeByDeviceRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeByDeviceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*GetSubscribeResponse) Descriptor() ([]byte, []int) {
 	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *SubscribeByDeviceRequest) GetId() string {
+func (x *GetSubscribeResponse) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *SubscribeByDeviceRequest) GetSubscribeIds() []string {
+func (x *GetSubscribeResponse) GetTitle() string {
 	if x != nil {
-		return x.SubscribeIds
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-type SubscribeByDeviceResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetSubscribeResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+func (x *GetSubscribeResponse) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
 }
 
-func (x *SubscribeByDeviceResponse) Reset() {
-	*x = SubscribeByDeviceResponse{}
+func (x *GetSubscribeResponse) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetSubscribeResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *GetSubscribeResponse) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *GetSubscribeResponse) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *GetSubscribeResponse) GetManaged() bool {
+	if x != nil {
+		return x.Managed
+	}
+	return false
+}
+
+func (x *GetSubscribeResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListSubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageNum      uint64 `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize     uint64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrderBy      string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	IsDescending bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
+	KeyWords     string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
+	SearchKey    string `protobuf:"bytes,6,opt,name=search_key,json=searchKey,proto3" json:"search_key,omitempty"`
+	PageToken    string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListSubscribeRequest) Reset() {
+	*x = ListSubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1764,610 +2002,6561 @@ func (x *SubscribeByDeviceResponse) Reset() {
 	}
 }
 
-func (x *SubscribeByDeviceResponse) String() string {
+func (x *ListSubscribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeByDeviceResponse) ProtoMessage() {}
+func (*ListSubscribeRequest) ProtoMessage() {}
 
-func (x *SubscribeByDeviceResponse) ProtoReflect() protoreflect.Message {
+func (x *ListSubscribeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SubscribeByDeviceResponse.ProtoReflect.Descriptor instead.
-func (*SubscribeByDeviceResponse) Descriptor() ([]byte, []int) {
-	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *SubscribeByDeviceResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-var File_api_subscribe_v1_subscribe_proto protoreflect.FileDescriptor
-
-var file_api_subscribe_v1_subscribe_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x10, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f,
-	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x6f, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe5, 0xae, 0x9e, 0xe4,
-	0xbd, 0x93, 0x69, 0x64, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x22, 0x6a, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49,
-	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9,
-	0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x6f, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
-	0xe7, 0xbb, 0x84, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x22, 0x6d, 0x0a, 0x21, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49,
-	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9,
-	0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x6c, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xa8, 0xa1, 0xe5, 0x9e, 0x8b,
-	0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x22, 0x6d,
-	0x0a, 0x21, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7,
-	0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7d, 0x0a,
-	0x1f, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41,
-	0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x3b, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x1a, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae,
-	0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0x49, 0x44, 0xe4,
-	0xbb, 0xac, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x20,
-	0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41,
-	0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6,
-	0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xe9, 0x02, 0x0a, 0x1c, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xe0,
-	0x41, 0x02, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x11,
-	0xe0, 0x41, 0x02, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x50, 0x61, 0x67, 0x65, 0x20, 0x73, 0x69, 0x7a,
-	0x65, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe0,
-	0x41, 0x01, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x62, 0x79, 0x52,
-	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x3a, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64,
-	0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x15, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0f, 0x32, 0x0d, 0x49, 0x73, 0x20, 0x64, 0x65, 0x73, 0x63,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0b, 0x32,
-	0x09, 0x4b, 0x65, 0x79, 0x20, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57,
-	0x6f, 0x72, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0c,
-	0x32, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
-	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x95, 0x02, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x07, 0x32,
-	0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2e, 0x0a,
-	0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x13, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x20, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a,
-	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x11, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x20, 0x70,
-	0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x11, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x50, 0x61, 0x67, 0x65, 0x20, 0x73,
-	0x69, 0x7a, 0x65, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3f, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9,
-	0x98, 0x85, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xfa,
-	0x01, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d,
-	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d,
-	0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8,
-	0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x65, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x12, 0x39, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
-	0xa6, 0xe4, 0xb8, 0xba, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
-	0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x76, 0x0a, 0x16, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
-	0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
-	0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x82, 0x02, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a,
-	0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27,
-	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
-	0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
-	0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08,
-	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13,
-	0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a,
-	0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8,
-	0xba, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x09, 0x69,
-	0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5,
-	0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f,
-	0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92,
-	0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x82, 0x02, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8,
-	0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
-	0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
-	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10,
-	0x32, 0x0e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x73,
-	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1a,
-	0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0xe9, 0xbb,
-	0x98, 0xe8, 0xae, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x37, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a,
-	0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x38,
-	0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9,
-	0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a,
-	0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x98,
-	0x03, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
-	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9,
-	0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
-	0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0xae, 0xa2,
-	0xe9, 0x98, 0x85, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
-	0x85, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5,
-	0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32,
-	0x12, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6,
-	0xe9, 0x97, 0xb4, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x08, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4,
-	0xb8, 0xba, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x09,
-	0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xc2, 0x02, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x50, 0x61,
-	0x67, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e,
-	0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x11, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x50,
-	0x61, 0x67, 0x65, 0x20, 0x73, 0x69, 0x7a, 0x65, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x20, 0x62, 0x79, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12,
-	0x3a, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x15, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0f, 0x32, 0x0d,
-	0x49, 0x73, 0x20, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x69,
-	0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x09, 0x6b,
-	0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
-	0xe0, 0x41, 0x01, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x4b, 0x65, 0x79, 0x20, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x12, 0xe0, 0x41, 0x01, 0x92, 0x41, 0x0c, 0x32, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20,
-	0x4b, 0x65, 0x79, 0x52, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22, 0x83,
-	0x02, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x07, 0x32,
-	0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2e, 0x0a,
-	0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x13, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x20, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a,
-	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x11, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x20, 0x70,
-	0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x11, 0xe0, 0x41, 0x02, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x50, 0x61, 0x67, 0x65, 0x20, 0x73,
-	0x69, 0x7a, 0x65, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x35, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0xac, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0x92, 0x41,
-	0x10, 0x32, 0x0e, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49,
-	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe7, 0x9b,
-	0xae, 0xe6, 0xa0, 0x87, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x08, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x16,
-	0x32, 0x14, 0xe8, 0xa2, 0xab, 0xe7, 0xa7, 0xbb, 0xe5, 0x8a, 0xa8, 0xe7, 0x9a, 0x84, 0xe8, 0xae,
-	0xbe, 0xe5, 0xa4, 0x87, 0x49, 0x44, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x49, 0x64, 0x73, 0x22, 0x45, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xe7, 0x8a, 0xb6, 0xe6,
-	0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xea, 0x01, 0x0a, 0x06, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69,
-	0x64, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x09, 0x92, 0x41, 0x06, 0x32, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x74, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
-	0x32, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x32, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2f, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32,
-	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3d, 0x0a, 0x19, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x32, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x52,
-	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x41, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x73, 0x0a, 0x18, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x20, 0x69,
-	0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x12, 0x92, 0x41,
-	0x0f, 0x32, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x69, 0x64, 0x73,
-	0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x64, 0x73, 0x22, 0x40,
-	0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08,
-	0x32, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x32, 0xcc, 0x16, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0xf2,
-	0x01, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79,
-	0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42,
-	0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x3a, 0x01,
-	0x2a, 0x92, 0x41, 0x4f, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
-	0x1d, 0x61, 0x64, 0x64, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x62, 0x79, 0x20, 0x69, 0x64, 0x73, 0x2a, 0x16,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a,
-	0x02, 0x4f, 0x4b, 0x12, 0xff, 0x01, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x12, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1b, 0x22, 0x16, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x55,
-	0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x20, 0x61, 0x64, 0x64,
-	0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x20, 0x62, 0x79, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2a, 0x19, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
-	0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xff, 0x01, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x12, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x92,
-	0x41, 0x55, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x20, 0x61,
-	0x64, 0x64, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x62, 0x79, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2a,
-	0x19, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30,
-	0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x85, 0x02, 0x0a, 0x18, 0x55, 0x6e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42,
-	0x79, 0x49, 0x44, 0x73, 0x12, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79,
-	0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x81, 0x01, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x54, 0x0a, 0x09, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x20, 0x62, 0x79, 0x20, 0x69, 0x64, 0x73, 0x2a, 0x18, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49,
-	0x44, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12,
-	0xf1, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x22, 0x22, 0x1d, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x6c, 0x69, 0x73,
-	0x74, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x4c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x12, 0x1b, 0x67, 0x65, 0x74, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x2a,
-	0x15, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a,
-	0x02, 0x4f, 0x4b, 0x12, 0xbb, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x3a, 0x01, 0x2a, 0x92, 0x41, 0x3b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x12, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x2a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f,
-	0x4b, 0x12, 0xc0, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x14, 0x32, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x3b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x12, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04,
-	0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xbd, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x11, 0x2a, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x3b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x12, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04,
-	0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xae, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x35,
-	0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x0d, 0x67, 0x65, 0x74,
-	0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2a, 0x0c, 0x67, 0x65, 0x74, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
-	0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xba, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
-	0x22, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x6c, 0x69, 0x73,
-	0x74, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x3b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x12, 0x12, 0x67, 0x65, 0x74, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x2a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
-	0x4f, 0x4b, 0x12, 0xda, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x1a, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x52, 0x0a, 0x09, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x27, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x6f,
-	0x74, 0x68, 0x65, 0x72, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x2a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12,
-	0xe8, 0x01, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x77, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x3a, 0x01,
-	0x2a, 0x92, 0x41, 0x56, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
-	0x28, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x20, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x20, 0x69, 0x73, 0x20, 0x75, 0x73, 0x65,
-	0x72, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x4a, 0x0b, 0x0a,
-	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0xd2, 0x01, 0x0a, 0x11, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1b, 0x22, 0x16, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x40, 0x0a,
-	0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x13, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x62, 0x79, 0x20, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2a,
-	0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x42,
-	0x49, 0x0a, 0x10, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2d,
-	0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
-}
-
-var (
-	file_api_subscribe_v1_subscribe_proto_rawDescOnce sync.Once
-	file_api_subscribe_v1_subscribe_proto_rawDescData = file_api_subscribe_v1_subscribe_proto_rawDesc
-)
-
-func file_api_subscribe_v1_subscribe_proto_rawDescGZIP() []byte {
-	file_api_subscribe_v1_subscribe_proto_rawDescOnce.Do(func() {
-		file_api_subscribe_v1_subscribe_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_subscribe_v1_subscribe_proto_rawDescData)
-	})
-	return file_api_subscribe_v1_subscribe_proto_rawDescData
-}
-
-var file_api_subscribe_v1_subscribe_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
-var file_api_subscribe_v1_subscribe_proto_goTypes = []interface{}{
-	(*SubscribeEntitiesByIDsRequest)(nil),     // 0: api.subscribe.v1.SubscribeEntitiesByIDsRequest
-	(*SubscribeEntitiesByIDsResponse)(nil),    // 1: api.subscribe.v1.SubscribeEntitiesByIDsResponse
-	(*SubscribeEntitiesByGroupsRequest)(nil),  // 2: api.subscribe.v1.SubscribeEntitiesByGroupsRequest
-	(*SubscribeEntitiesByGroupsResponse)(nil), // 3: api.subscribe.v1.SubscribeEntitiesByGroupsResponse
-	(*SubscribeEntitiesByModelsRequest)(nil),  // 4: api.subscribe.v1.SubscribeEntitiesByModelsRequest
-	(*SubscribeEntitiesByModelsResponse)(nil), // 5: api.subscribe.v1.SubscribeEntitiesByModelsResponse
-	(*UnsubscribeEntitiesByIDsRequest)(nil),   // 6: api.subscribe.v1.UnsubscribeEntitiesByIDsRequest
-	(*UnsubscribeEntitiesByIDsResponse)(nil),  // 7: api.subscribe.v1.UnsubscribeEntitiesByIDsResponse
-	(*ListSubscribeEntitiesRequest)(nil),      // 8: api.subscribe.v1.ListSubscribeEntitiesRequest
-	(*ListSubscribeEntitiesResponse)(nil),     // 9: api.subscribe.v1.ListSubscribeEntitiesResponse
-	(*SubscribeObject)(nil),                   // 10: api.subscribe.v1.SubscribeObject
-	(*CreateSubscribeRequest)(nil),            // 11: api.subscribe.v1.CreateSubscribeRequest
-	(*CreateSubscribeResponse)(nil),           // 12: api.subscribe.v1.CreateSubscribeResponse
-	(*UpdateSubscribeRequest)(nil),            // 13: api.subscribe.v1.UpdateSubscribeRequest
-	(*UpdateSubscribeResponse)(nil),           // 14: api.subscribe.v1.UpdateSubscribeResponse
-	(*DeleteSubscribeRequest)(nil),            // 15: api.subscribe.v1.DeleteSubscribeRequest
-	(*DeleteSubscribeResponse)(nil),           // 16: api.subscribe.v1.DeleteSubscribeResponse
-	(*GetSubscribeRequest)(nil),               // 17: api.subscribe.v1.GetSubscribeRequest
-	(*GetSubscribeResponse)(nil),              // 18: api.subscribe.v1.GetSubscribeResponse
-	(*ListSubscribeRequest)(nil),              // 19: api.subscribe.v1.ListSubscribeRequest
-	(*ListSubscribeResponse)(nil),             // 20: api.subscribe.v1.ListSubscribeResponse
-	(*ChangeSubscribedRequest)(nil),           // 21: api.subscribe.v1.ChangeSubscribedRequest
-	(*ChangeSubscribedResponse)(nil),          // 22: api.subscribe.v1.ChangeSubscribedResponse
-	(*Entity)(nil),                            // 23: api.subscribe.v1.Entity
-	(*ValidateSubscribedRequest)(nil),         // 24: api.subscribe.v1.ValidateSubscribedRequest
-	(*ValidateSubscribedResponse)(nil),        // 25: api.subscribe.v1.ValidateSubscribedResponse
-	(*SubscribeByDeviceRequest)(nil),          // 26: api.subscribe.v1.SubscribeByDeviceRequest
-	(*SubscribeByDeviceResponse)(nil),         // 27: api.subscribe.v1.SubscribeByDeviceResponse
-}
-var file_api_subscribe_v1_subscribe_proto_depIdxs = []int32{
-	23, // 0: api.subscribe.v1.ListSubscribeEntitiesResponse.data:type_name -> api.subscribe.v1.Entity
-	10, // 1: api.subscribe.v1.ListSubscribeResponse.data:type_name -> api.subscribe.v1.SubscribeObject
-	0,  // 2: api.subscribe.v1.Subscribe.SubscribeEntitiesByIDs:input_type -> api.subscribe.v1.SubscribeEntitiesByIDsRequest
-	2,  // 3: api.subscribe.v1.Subscribe.SubscribeEntitiesByGroups:input_type -> api.subscribe.v1.SubscribeEntitiesByGroupsRequest
-	4,  // 4: api.subscribe.v1.Subscribe.SubscribeEntitiesByModels:input_type -> api.subscribe.v1.SubscribeEntitiesByModelsRequest
-	6,  // 5: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByIDs:input_type -> api.subscribe.v1.UnsubscribeEntitiesByIDsRequest
-	8,  // 6: api.subscribe.v1.Subscribe.ListSubscribeEntities:input_type -> api.subscribe.v1.ListSubscribeEntitiesRequest
-	11, // 7: api.subscribe.v1.Subscribe.CreateSubscribe:input_type -> api.subscribe.v1.CreateSubscribeRequest
-	13, // 8: api.subscribe.v1.Subscribe.UpdateSubscribe:input_type -> api.subscribe.v1.UpdateSubscribeRequest
-	15, // 9: api.subscribe.v1.Subscribe.DeleteSubscribe:input_type -> api.subscribe.v1.DeleteSubscribeRequest
-	17, // 10: api.subscribe.v1.Subscribe.GetSubscribe:input_type -> api.subscribe.v1.GetSubscribeRequest
-	19, // 11: api.subscribe.v1.Subscribe.ListSubscribe:input_type -> api.subscribe.v1.ListSubscribeRequest
-	21, // 12: api.subscribe.v1.Subscribe.ChangeSubscribed:input_type -> api.subscribe.v1.ChangeSubscribedRequest
-	24, // 13: api.subscribe.v1.Subscribe.ValidateSubscribed:input_type -> api.subscribe.v1.ValidateSubscribedRequest
-	26, // 14: api.subscribe.v1.Subscribe.SubscribeByDevice:input_type -> api.subscribe.v1.SubscribeByDeviceRequest
-	1,  // 15: api.subscribe.v1.Subscribe.SubscribeEntitiesByIDs:output_type -> api.subscribe.v1.SubscribeEntitiesByIDsResponse
-	3,  // 16: api.subscribe.v1.Subscribe.SubscribeEntitiesByGroups:output_type -> api.subscribe.v1.SubscribeEntitiesByGroupsResponse
-	5,  // 17: api.subscribe.v1.Subscribe.SubscribeEntitiesByModels:output_type -> api.subscribe.v1.SubscribeEntitiesByModelsResponse
-	7,  // 18: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByIDs:output_type -> api.subscribe.v1.UnsubscribeEntitiesByIDsResponse
-	9,  // 19: api.subscribe.v1.Subscribe.ListSubscribeEntities:output_type -> api.subscribe.v1.ListSubscribeEntitiesResponse
-	12, // 20: api.subscribe.v1.Subscribe.CreateSubscribe:output_type -> api.subscribe.v1.CreateSubscribeResponse
-	14, // 21: api.subscribe.v1.Subscribe.UpdateSubscribe:output_type -> api.subscribe.v1.UpdateSubscribeResponse
-	16, // 22: api.subscribe.v1.Subscribe.DeleteSubscribe:output_type -> api.subscribe.v1.DeleteSubscribeResponse
-	18, // 23: api.subscribe.v1.Subscribe.GetSubscribe:output_type -> api.subscribe.v1.GetSubscribeResponse
-	20, // 24: api.subscribe.v1.Subscribe.ListSubscribe:output_type -> api.subscribe.v1.ListSubscribeResponse
-	22, // 25: api.subscribe.v1.Subscribe.ChangeSubscribed:output_type -> api.subscribe.v1.ChangeSubscribedResponse
-	25, // 26: api.subscribe.v1.Subscribe.ValidateSubscribed:output_type -> api.subscribe.v1.ValidateSubscribedResponse
-	27, // 27: api.subscribe.v1.Subscribe.SubscribeByDevice:output_type -> api.subscribe.v1.SubscribeByDeviceResponse
-	15, // [15:28] is the sub-list for method output_type
-	2,  // [2:15] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
-}
-
-func init() { file_api_subscribe_v1_subscribe_proto_init() }
-func file_api_subscribe_v1_subscribe_proto_init() {
-	if File_api_subscribe_v1_subscribe_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_subscribe_v1_subscribe_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeEntitiesByIDsRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListSubscribeRequest) GetPageNum() uint64 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListSubscribeRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSubscribeRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListSubscribeRequest) GetIsDescending() bool {
+	if x != nil {
+		return x.IsDescending
+	}
+	return false
+}
+
+func (x *ListSubscribeRequest) GetKeyWords() string {
+	if x != nil {
+		return x.KeyWords
+	}
+	return ""
+}
+
+func (x *ListSubscribeRequest) GetSearchKey() string {
+	if x != nil {
+		return x.SearchKey
+	}
+	return ""
+}
+
+func (x *ListSubscribeRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total         uint64             `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageNum       uint64             `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	LastPage      uint64             `protobuf:"varint,3,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
+	PageSize      uint64             `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Data          []*SubscribeObject `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	NextPageToken string             `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListSubscribeResponse) Reset() {
+	*x = ListSubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribeResponse) ProtoMessage() {}
+
+func (x *ListSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListSubscribeResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListSubscribeResponse) GetPageNum() uint64 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListSubscribeResponse) GetLastPage() uint64 {
+	if x != nil {
+		return x.LastPage
+	}
+	return 0
+}
+
+func (x *ListSubscribeResponse) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSubscribeResponse) GetData() []*SubscribeObject {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListSubscribeResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ChangeSubscribedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetId     uint64   `protobuf:"varint,2,opt,name=targetId,proto3" json:"targetId,omitempty"`
+	SelectedIds  []string `protobuf:"bytes,3,rep,name=selectedIds,proto3" json:"selectedIds,omitempty"`
+	DryRun       bool     `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Mode         string   `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	AllOrNothing bool     `protobuf:"varint,6,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+}
+
+func (x *ChangeSubscribedRequest) Reset() {
+	*x = ChangeSubscribedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeSubscribedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSubscribedRequest) ProtoMessage() {}
+
+func (x *ChangeSubscribedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSubscribedRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSubscribedRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChangeSubscribedRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChangeSubscribedRequest) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *ChangeSubscribedRequest) GetSelectedIds() []string {
+	if x != nil {
+		return x.SelectedIds
+	}
+	return nil
+}
+
+func (x *ChangeSubscribedRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ChangeSubscribedRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ChangeSubscribedRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+type ChangeSubscribedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  string           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results []*EntityResult  `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	DryRun  bool             `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Quota   *QuotaProjection `protobuf:"bytes,4,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (x *ChangeSubscribedResponse) Reset() {
+	*x = ChangeSubscribedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeSubscribedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSubscribedResponse) ProtoMessage() {}
+
+func (x *ChangeSubscribedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSubscribedResponse.ProtoReflect.Descriptor instead.
+func (*ChangeSubscribedResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ChangeSubscribedResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChangeSubscribedResponse) GetResults() []*EntityResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ChangeSubscribedResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ChangeSubscribedResponse) GetQuota() *QuotaProjection {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type Entity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status       string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Template     string `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
+	Group        string `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
+	UpdatedAt    int64  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SubscribedAt int64  `protobuf:"varint,7,opt,name=subscribed_at,json=subscribedAt,proto3" json:"subscribed_at,omitempty"`
+}
+
+func (x *Entity) Reset() {
+	*x = Entity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entity) ProtoMessage() {}
+
+func (x *Entity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Entity) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Entity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Entity) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Entity) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *Entity) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *Entity) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Entity) GetSubscribedAt() int64 {
+	if x != nil {
+		return x.SubscribedAt
+	}
+	return 0
+}
+
+type ValidateSubscribedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *ValidateSubscribedRequest) Reset() {
+	*x = ValidateSubscribedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateSubscribedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateSubscribedRequest) ProtoMessage() {}
+
+func (x *ValidateSubscribedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateSubscribedRequest.ProtoReflect.Descriptor instead.
+func (*ValidateSubscribedRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ValidateSubscribedRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+type ValidateSubscribedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ValidateSubscribedResponse) Reset() {
+	*x = ValidateSubscribedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateSubscribedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateSubscribedResponse) ProtoMessage() {}
+
+func (x *ValidateSubscribedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateSubscribedResponse.ProtoReflect.Descriptor instead.
+func (*ValidateSubscribedResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ValidateSubscribedResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SubscribeByDeviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscribeIds []string `protobuf:"bytes,2,rep,name=subscribe_ids,json=subscribeIds,proto3" json:"subscribe_ids,omitempty"`
+}
+
+func (x *SubscribeByDeviceRequest) Reset() {
+	*x = SubscribeByDeviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeByDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeByDeviceRequest) ProtoMessage() {}
+
+func (x *SubscribeByDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeByDeviceRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeByDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SubscribeByDeviceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubscribeByDeviceRequest) GetSubscribeIds() []string {
+	if x != nil {
+		return x.SubscribeIds
+	}
+	return nil
+}
+
+type SubscribeByDeviceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  string                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results []*EntityResult       `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Summary *BulkSubscribeSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *SubscribeByDeviceResponse) Reset() {
+	*x = SubscribeByDeviceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeByDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeByDeviceResponse) ProtoMessage() {}
+
+func (x *SubscribeByDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeByDeviceResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeByDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SubscribeByDeviceResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SubscribeByDeviceResponse) GetResults() []*EntityResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SubscribeByDeviceResponse) GetSummary() *BulkSubscribeSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type ReplaceSubscribeByDeviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscribeIds []string `protobuf:"bytes,2,rep,name=subscribe_ids,json=subscribeIds,proto3" json:"subscribe_ids,omitempty"`
+}
+
+func (x *ReplaceSubscribeByDeviceRequest) Reset() {
+	*x = ReplaceSubscribeByDeviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceSubscribeByDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceSubscribeByDeviceRequest) ProtoMessage() {}
+
+func (x *ReplaceSubscribeByDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceSubscribeByDeviceRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceSubscribeByDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReplaceSubscribeByDeviceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReplaceSubscribeByDeviceRequest) GetSubscribeIds() []string {
+	if x != nil {
+		return x.SubscribeIds
+	}
+	return nil
+}
+
+type ReplaceSubscribeByDeviceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  string          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results []*EntityResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ReplaceSubscribeByDeviceResponse) Reset() {
+	*x = ReplaceSubscribeByDeviceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceSubscribeByDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceSubscribeByDeviceResponse) ProtoMessage() {}
+
+func (x *ReplaceSubscribeByDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceSubscribeByDeviceResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceSubscribeByDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReplaceSubscribeByDeviceResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReplaceSubscribeByDeviceResponse) GetResults() []*EntityResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ListSubscriptionsByEntityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListSubscriptionsByEntityRequest) Reset() {
+	*x = ListSubscriptionsByEntityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscriptionsByEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsByEntityRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsByEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsByEntityRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsByEntityRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListSubscriptionsByEntityRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListSubscriptionsByEntityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*SubscribeObject `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListSubscriptionsByEntityResponse) Reset() {
+	*x = ListSubscriptionsByEntityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscriptionsByEntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsByEntityResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsByEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsByEntityResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsByEntityResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListSubscriptionsByEntityResponse) GetData() []*SubscribeObject {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListSubscribeOperationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageNum      uint64 `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize     uint64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrderBy      string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	IsDescending bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
+	KeyWords     string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
+	SearchKey    string `protobuf:"bytes,6,opt,name=search_key,json=searchKey,proto3" json:"search_key,omitempty"`
+	Id           uint64 `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	Status       string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	PageToken    string `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListSubscribeOperationsRequest) Reset() {
+	*x = ListSubscribeOperationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscribeOperationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribeOperationsRequest) ProtoMessage() {}
+
+func (x *ListSubscribeOperationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribeOperationsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscribeOperationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListSubscribeOperationsRequest) GetPageNum() uint64 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListSubscribeOperationsRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSubscribeOperationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListSubscribeOperationsRequest) GetIsDescending() bool {
+	if x != nil {
+		return x.IsDescending
+	}
+	return false
+}
+
+func (x *ListSubscribeOperationsRequest) GetKeyWords() string {
+	if x != nil {
+		return x.KeyWords
+	}
+	return ""
+}
+
+func (x *ListSubscribeOperationsRequest) GetSearchKey() string {
+	if x != nil {
+		return x.SearchKey
+	}
+	return ""
+}
+
+func (x *ListSubscribeOperationsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListSubscribeOperationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListSubscribeOperationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSubscribeOperationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total         uint64                `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageNum       uint64                `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	LastPage      uint64                `protobuf:"varint,3,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
+	PageSize      uint64                `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Data          []*SubscribeOperation `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	NextPageToken string                `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListSubscribeOperationsResponse) Reset() {
+	*x = ListSubscribeOperationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscribeOperationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribeOperationsResponse) ProtoMessage() {}
+
+func (x *ListSubscribeOperationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribeOperationsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscribeOperationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListSubscribeOperationsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListSubscribeOperationsResponse) GetPageNum() uint64 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListSubscribeOperationsResponse) GetLastPage() uint64 {
+	if x != nil {
+		return x.LastPage
+	}
+	return 0
+}
+
+func (x *ListSubscribeOperationsResponse) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSubscribeOperationsResponse) GetData() []*SubscribeOperation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListSubscribeOperationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SubscribeOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntityId  string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Operation string `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts  int32  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt int64  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *SubscribeOperation) Reset() {
+	*x = SubscribeOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeOperation) ProtoMessage() {}
+
+func (x *SubscribeOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeOperation.ProtoReflect.Descriptor instead.
+func (*SubscribeOperation) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SubscribeOperation) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubscribeOperation) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *SubscribeOperation) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *SubscribeOperation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SubscribeOperation) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *SubscribeOperation) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *SubscribeOperation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SubscribeOperation) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type BulkSubscribeSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Created   uint64 `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Existing  uint64 `protobuf:"varint,3,opt,name=existing,proto3" json:"existing,omitempty"`
+	Failed    uint64 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	NotFound  uint64 `protobuf:"varint,5,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	Forbidden uint64 `protobuf:"varint,6,opt,name=forbidden,proto3" json:"forbidden,omitempty"`
+}
+
+func (x *BulkSubscribeSummary) Reset() {
+	*x = BulkSubscribeSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkSubscribeSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkSubscribeSummary) ProtoMessage() {}
+
+func (x *BulkSubscribeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkSubscribeSummary.ProtoReflect.Descriptor instead.
+func (*BulkSubscribeSummary) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BulkSubscribeSummary) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BulkSubscribeSummary) GetCreated() uint64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *BulkSubscribeSummary) GetExisting() uint64 {
+	if x != nil {
+		return x.Existing
+	}
+	return 0
+}
+
+func (x *BulkSubscribeSummary) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkSubscribeSummary) GetNotFound() uint64 {
+	if x != nil {
+		return x.NotFound
+	}
+	return 0
+}
+
+func (x *BulkSubscribeSummary) GetForbidden() uint64 {
+	if x != nil {
+		return x.Forbidden
+	}
+	return 0
+}
+
+type BulkUnsubscribeSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total         uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Removed       uint64 `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
+	NotSubscribed uint64 `protobuf:"varint,3,opt,name=not_subscribed,json=notSubscribed,proto3" json:"not_subscribed,omitempty"`
+}
+
+func (x *BulkUnsubscribeSummary) Reset() {
+	*x = BulkUnsubscribeSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkUnsubscribeSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUnsubscribeSummary) ProtoMessage() {}
+
+func (x *BulkUnsubscribeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUnsubscribeSummary.ProtoReflect.Descriptor instead.
+func (*BulkUnsubscribeSummary) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BulkUnsubscribeSummary) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BulkUnsubscribeSummary) GetRemoved() uint64 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *BulkUnsubscribeSummary) GetNotSubscribed() uint64 {
+	if x != nil {
+		return x.NotSubscribed
+	}
+	return 0
+}
+
+type EntityResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId    string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Result      string `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Reason      string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	SubscribeId uint64 `protobuf:"varint,4,opt,name=subscribe_id,json=subscribeId,proto3" json:"subscribe_id,omitempty"`
+}
+
+func (x *EntityResult) Reset() {
+	*x = EntityResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityResult) ProtoMessage() {}
+
+func (x *EntityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityResult.ProtoReflect.Descriptor instead.
+func (*EntityResult) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *EntityResult) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *EntityResult) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *EntityResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EntityResult) GetSubscribeId() uint64 {
+	if x != nil {
+		return x.SubscribeId
+	}
+	return 0
+}
+
+type GetSubscribeProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSubscribeProgressRequest) Reset() {
+	*x = GetSubscribeProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscribeProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscribeProgressRequest) ProtoMessage() {}
+
+func (x *GetSubscribeProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscribeProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscribeProgressRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetSubscribeProgressRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSubscribeProgressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Entities uint64 `protobuf:"varint,2,opt,name=entities,proto3" json:"entities,omitempty"`
+	Pending  uint64 `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	Done     uint64 `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	Failed   uint64 `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *GetSubscribeProgressResponse) Reset() {
+	*x = GetSubscribeProgressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscribeProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscribeProgressResponse) ProtoMessage() {}
+
+func (x *GetSubscribeProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscribeProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetSubscribeProgressResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetSubscribeProgressResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetSubscribeProgressResponse) GetEntities() uint64 {
+	if x != nil {
+		return x.Entities
+	}
+	return 0
+}
+
+func (x *GetSubscribeProgressResponse) GetPending() uint64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *GetSubscribeProgressResponse) GetDone() uint64 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *GetSubscribeProgressResponse) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type GetQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetQuotaRequest) Reset() {
+	*x = GetQuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaRequest) ProtoMessage() {}
+
+func (x *GetQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{48}
+}
+
+type GetQuotaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscribes                uint64 `protobuf:"varint,1,opt,name=subscribes,proto3" json:"subscribes,omitempty"`
+	SubscribesLimit           uint64 `protobuf:"varint,2,opt,name=subscribes_limit,json=subscribesLimit,proto3" json:"subscribes_limit,omitempty"`
+	EntitiesPerSubscribeLimit uint64 `protobuf:"varint,3,opt,name=entities_per_subscribe_limit,json=entitiesPerSubscribeLimit,proto3" json:"entities_per_subscribe_limit,omitempty"`
+	TenantEntities            uint64 `protobuf:"varint,4,opt,name=tenant_entities,json=tenantEntities,proto3" json:"tenant_entities,omitempty"`
+	TenantEntitiesLimit       uint64 `protobuf:"varint,5,opt,name=tenant_entities_limit,json=tenantEntitiesLimit,proto3" json:"tenant_entities_limit,omitempty"`
+}
+
+func (x *GetQuotaResponse) Reset() {
+	*x = GetQuotaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaResponse) ProtoMessage() {}
+
+func (x *GetQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetQuotaResponse) GetSubscribes() uint64 {
+	if x != nil {
+		return x.Subscribes
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetSubscribesLimit() uint64 {
+	if x != nil {
+		return x.SubscribesLimit
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetEntitiesPerSubscribeLimit() uint64 {
+	if x != nil {
+		return x.EntitiesPerSubscribeLimit
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetTenantEntities() uint64 {
+	if x != nil {
+		return x.TenantEntities
+	}
+	return 0
+}
+
+func (x *GetQuotaResponse) GetTenantEntitiesLimit() uint64 {
+	if x != nil {
+		return x.TenantEntitiesLimit
+	}
+	return 0
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageNum      uint64 `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize     uint64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrderBy      string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	IsDescending bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
+	KeyWords     string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
+	SearchKey    string `protobuf:"bytes,6,opt,name=search_key,json=searchKey,proto3" json:"search_key,omitempty"`
+	SubscribeId  uint64 `protobuf:"varint,7,opt,name=subscribe_id,json=subscribeId,proto3" json:"subscribe_id,omitempty"`
+	EntityId     string `protobuf:"bytes,8,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Actor        string `protobuf:"bytes,9,opt,name=actor,proto3" json:"actor,omitempty"`
+	StartTime    int64  `protobuf:"varint,10,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime      int64  `protobuf:"varint,11,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PageToken    string `protobuf:"bytes,12,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListAuditEventsRequest) GetPageNum() uint64 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetIsDescending() bool {
+	if x != nil {
+		return x.IsDescending
+	}
+	return false
+}
+
+func (x *ListAuditEventsRequest) GetKeyWords() string {
+	if x != nil {
+		return x.KeyWords
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSearchKey() string {
+	if x != nil {
+		return x.SearchKey
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSubscribeId() uint64 {
+	if x != nil {
+		return x.SubscribeId
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total         uint64        `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageNum       uint64        `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	LastPage      uint64        `protobuf:"varint,3,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
+	PageSize      uint64        `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Data          []*AuditEvent `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	NextPageToken string        `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListAuditEventsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListAuditEventsResponse) GetPageNum() uint64 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListAuditEventsResponse) GetLastPage() uint64 {
+	if x != nil {
+		return x.LastPage
+	}
+	return 0
+}
+
+func (x *ListAuditEventsResponse) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsResponse) GetData() []*AuditEvent {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Actor       string   `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action      string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	SubscribeId uint64   `protobuf:"varint,4,opt,name=subscribe_id,json=subscribeId,proto3" json:"subscribe_id,omitempty"`
+	Entities    []string `protobuf:"bytes,5,rep,name=entities,proto3" json:"entities,omitempty"`
+	Before      string   `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After       string   `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	Outcome     string   `protobuf:"bytes,8,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Error       string   `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt   int64    `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AuditEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetSubscribeId() uint64 {
+	if x != nil {
+		return x.SubscribeId
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetEntities() []string {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListDeletedSubscribesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageNum      uint64 `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize     uint64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrderBy      string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	IsDescending bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
+	KeyWords     string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
+	SearchKey    string `protobuf:"bytes,6,opt,name=search_key,json=searchKey,proto3" json:"search_key,omitempty"`
+	PageToken    string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListDeletedSubscribesRequest) Reset() {
+	*x = ListDeletedSubscribesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedSubscribesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedSubscribesRequest) ProtoMessage() {}
+
+func (x *ListDeletedSubscribesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedSubscribesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedSubscribesRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListDeletedSubscribesRequest) GetPageNum() uint64 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListDeletedSubscribesRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeletedSubscribesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListDeletedSubscribesRequest) GetIsDescending() bool {
+	if x != nil {
+		return x.IsDescending
+	}
+	return false
+}
+
+func (x *ListDeletedSubscribesRequest) GetKeyWords() string {
+	if x != nil {
+		return x.KeyWords
+	}
+	return ""
+}
+
+func (x *ListDeletedSubscribesRequest) GetSearchKey() string {
+	if x != nil {
+		return x.SearchKey
+	}
+	return ""
+}
+
+func (x *ListDeletedSubscribesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDeletedSubscribesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total         uint64              `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageNum       uint64              `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	LastPage      uint64              `protobuf:"varint,3,opt,name=last_page,json=lastPage,proto3" json:"last_page,omitempty"`
+	PageSize      uint64              `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Data          []*DeletedSubscribe `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	NextPageToken string              `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListDeletedSubscribesResponse) Reset() {
+	*x = ListDeletedSubscribesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedSubscribesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedSubscribesResponse) ProtoMessage() {}
+
+func (x *ListDeletedSubscribesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedSubscribesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedSubscribesResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListDeletedSubscribesResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListDeletedSubscribesResponse) GetPageNum() uint64 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListDeletedSubscribesResponse) GetLastPage() uint64 {
+	if x != nil {
+		return x.LastPage
+	}
+	return 0
+}
+
+func (x *ListDeletedSubscribesResponse) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeletedSubscribesResponse) GetData() []*DeletedSubscribe {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListDeletedSubscribesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DeletedSubscribe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	IsDefault   bool   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Entities    uint64 `protobuf:"varint,6,opt,name=entities,proto3" json:"entities,omitempty"`
+	DeletedAt   int64  `protobuf:"varint,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PurgeAt     int64  `protobuf:"varint,8,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+}
+
+func (x *DeletedSubscribe) Reset() {
+	*x = DeletedSubscribe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletedSubscribe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedSubscribe) ProtoMessage() {}
+
+func (x *DeletedSubscribe) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedSubscribe.ProtoReflect.Descriptor instead.
+func (*DeletedSubscribe) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeletedSubscribe) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeletedSubscribe) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DeletedSubscribe) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DeletedSubscribe) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *DeletedSubscribe) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *DeletedSubscribe) GetEntities() uint64 {
+	if x != nil {
+		return x.Entities
+	}
+	return 0
+}
+
+func (x *DeletedSubscribe) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *DeletedSubscribe) GetPurgeAt() int64 {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return 0
+}
+
+type RestoreSubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreSubscribeRequest) Reset() {
+	*x = RestoreSubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreSubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSubscribeRequest) ProtoMessage() {}
+
+func (x *RestoreSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RestoreSubscribeRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreSubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Endpoint    string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	IsDefault   bool   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Entities    uint64 `protobuf:"varint,6,opt,name=entities,proto3" json:"entities,omitempty"`
+}
+
+func (x *RestoreSubscribeResponse) Reset() {
+	*x = RestoreSubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreSubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSubscribeResponse) ProtoMessage() {}
+
+func (x *RestoreSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RestoreSubscribeResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RestoreSubscribeResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RestoreSubscribeResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RestoreSubscribeResponse) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *RestoreSubscribeResponse) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *RestoreSubscribeResponse) GetEntities() uint64 {
+	if x != nil {
+		return x.Entities
+	}
+	return 0
+}
+
+type SelectorCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SelectorCondition) Reset() {
+	*x = SelectorCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectorCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectorCondition) ProtoMessage() {}
+
+func (x *SelectorCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectorCondition.ProtoReflect.Descriptor instead.
+func (*SelectorCondition) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SelectorCondition) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SelectorCondition) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *SelectorCondition) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SubscribeSelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscribeId      uint64               `protobuf:"varint,2,opt,name=subscribe_id,json=subscribeId,proto3" json:"subscribe_id,omitempty"`
+	Kind             string               `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Value            string               `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	IncludeSubgroups bool                 `protobuf:"varint,5,opt,name=include_subgroups,json=includeSubgroups,proto3" json:"include_subgroups,omitempty"`
+	Conditions       []*SelectorCondition `protobuf:"bytes,6,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Entities         int64                `protobuf:"varint,7,opt,name=entities,proto3" json:"entities,omitempty"`
+	LastSyncedAt     int64                `protobuf:"varint,8,opt,name=last_synced_at,json=lastSyncedAt,proto3" json:"last_synced_at,omitempty"`
+	LastError        string               `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (x *SubscribeSelector) Reset() {
+	*x = SubscribeSelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSelector) ProtoMessage() {}
+
+func (x *SubscribeSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSelector.ProtoReflect.Descriptor instead.
+func (*SubscribeSelector) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SubscribeSelector) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubscribeSelector) GetSubscribeId() uint64 {
+	if x != nil {
+		return x.SubscribeId
+	}
+	return 0
+}
+
+func (x *SubscribeSelector) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SubscribeSelector) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SubscribeSelector) GetIncludeSubgroups() bool {
+	if x != nil {
+		return x.IncludeSubgroups
+	}
+	return false
+}
+
+func (x *SubscribeSelector) GetConditions() []*SelectorCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *SubscribeSelector) GetEntities() int64 {
+	if x != nil {
+		return x.Entities
+	}
+	return 0
+}
+
+func (x *SubscribeSelector) GetLastSyncedAt() int64 {
+	if x != nil {
+		return x.LastSyncedAt
+	}
+	return 0
+}
+
+func (x *SubscribeSelector) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type SelectorSyncResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SelectorId uint64   `protobuf:"varint,1,opt,name=selector_id,json=selectorId,proto3" json:"selector_id,omitempty"`
+	Attached   []string `protobuf:"bytes,2,rep,name=attached,proto3" json:"attached,omitempty"`
+	Detached   []string `protobuf:"bytes,3,rep,name=detached,proto3" json:"detached,omitempty"`
+	Error      string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SelectorSyncResult) Reset() {
+	*x = SelectorSyncResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectorSyncResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectorSyncResult) ProtoMessage() {}
+
+func (x *SelectorSyncResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectorSyncResult.ProtoReflect.Descriptor instead.
+func (*SelectorSyncResult) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SelectorSyncResult) GetSelectorId() uint64 {
+	if x != nil {
+		return x.SelectorId
+	}
+	return 0
+}
+
+func (x *SelectorSyncResult) GetAttached() []string {
+	if x != nil {
+		return x.Attached
+	}
+	return nil
+}
+
+func (x *SelectorSyncResult) GetDetached() []string {
+	if x != nil {
+		return x.Detached
+	}
+	return nil
+}
+
+func (x *SelectorSyncResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CreateSubscribeSelectorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind             string               `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Value            string               `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	IncludeSubgroups bool                 `protobuf:"varint,4,opt,name=include_subgroups,json=includeSubgroups,proto3" json:"include_subgroups,omitempty"`
+	Conditions       []*SelectorCondition `protobuf:"bytes,5,rep,name=conditions,proto3" json:"conditions,omitempty"`
+}
+
+func (x *CreateSubscribeSelectorRequest) Reset() {
+	*x = CreateSubscribeSelectorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSubscribeSelectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscribeSelectorRequest) ProtoMessage() {}
+
+func (x *CreateSubscribeSelectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscribeSelectorRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscribeSelectorRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateSubscribeSelectorRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateSubscribeSelectorRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateSubscribeSelectorRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CreateSubscribeSelectorRequest) GetIncludeSubgroups() bool {
+	if x != nil {
+		return x.IncludeSubgroups
+	}
+	return false
+}
+
+func (x *CreateSubscribeSelectorRequest) GetConditions() []*SelectorCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type CreateSubscribeSelectorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Selector *SubscribeSelector  `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	Sync     *SelectorSyncResult `protobuf:"bytes,2,opt,name=sync,proto3" json:"sync,omitempty"`
+}
+
+func (x *CreateSubscribeSelectorResponse) Reset() {
+	*x = CreateSubscribeSelectorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSubscribeSelectorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscribeSelectorResponse) ProtoMessage() {}
+
+func (x *CreateSubscribeSelectorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscribeSelectorResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubscribeSelectorResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateSubscribeSelectorResponse) GetSelector() *SubscribeSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *CreateSubscribeSelectorResponse) GetSync() *SelectorSyncResult {
+	if x != nil {
+		return x.Sync
+	}
+	return nil
+}
+
+type ListSubscribeSelectorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListSubscribeSelectorsRequest) Reset() {
+	*x = ListSubscribeSelectorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscribeSelectorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribeSelectorsRequest) ProtoMessage() {}
+
+func (x *ListSubscribeSelectorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribeSelectorsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscribeSelectorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListSubscribeSelectorsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListSubscribeSelectorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*SubscribeSelector `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListSubscribeSelectorsResponse) Reset() {
+	*x = ListSubscribeSelectorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscribeSelectorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscribeSelectorsResponse) ProtoMessage() {}
+
+func (x *ListSubscribeSelectorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscribeSelectorsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscribeSelectorsResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListSubscribeSelectorsResponse) GetData() []*SubscribeSelector {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteSubscribeSelectorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SelectorId uint64 `protobuf:"varint,2,opt,name=selector_id,json=selectorId,proto3" json:"selector_id,omitempty"`
+}
+
+func (x *DeleteSubscribeSelectorRequest) Reset() {
+	*x = DeleteSubscribeSelectorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSubscribeSelectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscribeSelectorRequest) ProtoMessage() {}
+
+func (x *DeleteSubscribeSelectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscribeSelectorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubscribeSelectorRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeleteSubscribeSelectorRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteSubscribeSelectorRequest) GetSelectorId() uint64 {
+	if x != nil {
+		return x.SelectorId
+	}
+	return 0
+}
+
+type DeleteSubscribeSelectorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sync *SelectorSyncResult `protobuf:"bytes,2,opt,name=sync,proto3" json:"sync,omitempty"`
+}
+
+func (x *DeleteSubscribeSelectorResponse) Reset() {
+	*x = DeleteSubscribeSelectorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSubscribeSelectorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscribeSelectorResponse) ProtoMessage() {}
+
+func (x *DeleteSubscribeSelectorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscribeSelectorResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubscribeSelectorResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteSubscribeSelectorResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteSubscribeSelectorResponse) GetSync() *SelectorSyncResult {
+	if x != nil {
+		return x.Sync
+	}
+	return nil
+}
+
+type SyncSubscribeSelectorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SyncSubscribeSelectorsRequest) Reset() {
+	*x = SyncSubscribeSelectorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncSubscribeSelectorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncSubscribeSelectorsRequest) ProtoMessage() {}
+
+func (x *SyncSubscribeSelectorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncSubscribeSelectorsRequest.ProtoReflect.Descriptor instead.
+func (*SyncSubscribeSelectorsRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SyncSubscribeSelectorsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SyncSubscribeSelectorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Results []*SelectorSyncResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SyncSubscribeSelectorsResponse) Reset() {
+	*x = SyncSubscribeSelectorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncSubscribeSelectorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncSubscribeSelectorsResponse) ProtoMessage() {}
+
+func (x *SyncSubscribeSelectorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncSubscribeSelectorsResponse.ProtoReflect.Descriptor instead.
+func (*SyncSubscribeSelectorsResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SyncSubscribeSelectorsResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SyncSubscribeSelectorsResponse) GetResults() []*SelectorSyncResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ExportSubscribesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids    []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Format string   `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *ExportSubscribesRequest) Reset() {
+	*x = ExportSubscribesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSubscribesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSubscribesRequest) ProtoMessage() {}
+
+func (x *ExportSubscribesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSubscribesRequest.ProtoReflect.Descriptor instead.
+func (*ExportSubscribesRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ExportSubscribesRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ExportSubscribesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportSubscribesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format   string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Document string `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+	Count    uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ExportSubscribesResponse) Reset() {
+	*x = ExportSubscribesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSubscribesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSubscribesResponse) ProtoMessage() {}
+
+func (x *ExportSubscribesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSubscribesResponse.ProtoReflect.Descriptor instead.
+func (*ExportSubscribesResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ExportSubscribesResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportSubscribesResponse) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+func (x *ExportSubscribesResponse) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ImportSubscribesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Document string `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	Conflict string `protobuf:"bytes,2,opt,name=conflict,proto3" json:"conflict,omitempty"`
+}
+
+func (x *ImportSubscribesRequest) Reset() {
+	*x = ImportSubscribesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSubscribesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSubscribesRequest) ProtoMessage() {}
+
+func (x *ImportSubscribesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSubscribesRequest.ProtoReflect.Descriptor instead.
+func (*ImportSubscribesRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ImportSubscribesRequest) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+func (x *ImportSubscribesRequest) GetConflict() string {
+	if x != nil {
+		return x.Conflict
+	}
+	return ""
+}
+
+type ImportSubscribeResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title           string                `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Id              uint64                `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	ImportedTitle   string                `protobuf:"bytes,3,opt,name=imported_title,json=importedTitle,proto3" json:"imported_title,omitempty"`
+	Result          string                `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	Reason          string                `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	SkippedEntities []*EntityResult       `protobuf:"bytes,6,rep,name=skipped_entities,json=skippedEntities,proto3" json:"skipped_entities,omitempty"`
+	Selectors       []*SelectorSyncResult `protobuf:"bytes,7,rep,name=selectors,proto3" json:"selectors,omitempty"`
+}
+
+func (x *ImportSubscribeResult) Reset() {
+	*x = ImportSubscribeResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSubscribeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSubscribeResult) ProtoMessage() {}
+
+func (x *ImportSubscribeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSubscribeResult.ProtoReflect.Descriptor instead.
+func (*ImportSubscribeResult) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ImportSubscribeResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportSubscribeResult) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImportSubscribeResult) GetImportedTitle() string {
+	if x != nil {
+		return x.ImportedTitle
+	}
+	return ""
+}
+
+func (x *ImportSubscribeResult) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ImportSubscribeResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImportSubscribeResult) GetSkippedEntities() []*EntityResult {
+	if x != nil {
+		return x.SkippedEntities
+	}
+	return nil
+}
+
+func (x *ImportSubscribeResult) GetSelectors() []*SelectorSyncResult {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+type ImportSubscribesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  string                   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results []*ImportSubscribeResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ImportSubscribesResponse) Reset() {
+	*x = ImportSubscribesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSubscribesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSubscribesResponse) ProtoMessage() {}
+
+func (x *ImportSubscribesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSubscribesResponse.ProtoReflect.Descriptor instead.
+func (*ImportSubscribesResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ImportSubscribesResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportSubscribesResponse) GetResults() []*ImportSubscribeResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ListManifestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListManifestsRequest) Reset() {
+	*x = ListManifestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListManifestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManifestsRequest) ProtoMessage() {}
+
+func (x *ListManifestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManifestsRequest.ProtoReflect.Descriptor instead.
+func (*ListManifestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{74}
+}
+
+type ListManifestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Manifests []*ManifestStatus `protobuf:"bytes,1,rep,name=manifests,proto3" json:"manifests,omitempty"`
+}
+
+func (x *ListManifestsResponse) Reset() {
+	*x = ListManifestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListManifestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManifestsResponse) ProtoMessage() {}
+
+func (x *ListManifestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManifestsResponse.ProtoReflect.Descriptor instead.
+func (*ListManifestsResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListManifestsResponse) GetManifests() []*ManifestStatus {
+	if x != nil {
+		return x.Manifests
+	}
+	return nil
+}
+
+type ManifestStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File       string                     `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	User       string                     `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	AppliedAt  int64                      `protobuf:"varint,3,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
+	Error      string                     `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Subscribes []*ManifestSubscribeStatus `protobuf:"bytes,5,rep,name=subscribes,proto3" json:"subscribes,omitempty"`
+}
+
+func (x *ManifestStatus) Reset() {
+	*x = ManifestStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManifestStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestStatus) ProtoMessage() {}
+
+func (x *ManifestStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestStatus.ProtoReflect.Descriptor instead.
+func (*ManifestStatus) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ManifestStatus) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *ManifestStatus) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ManifestStatus) GetAppliedAt() int64 {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return 0
+}
+
+func (x *ManifestStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ManifestStatus) GetSubscribes() []*ManifestSubscribeStatus {
+	if x != nil {
+		return x.Subscribes
+	}
+	return nil
+}
+
+type ManifestSubscribeStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title     string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Id        uint64   `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Result    string   `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	Error     string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Drift     []string `protobuf:"bytes,5,rep,name=drift,proto3" json:"drift,omitempty"`
+	DriftedAt int64    `protobuf:"varint,6,opt,name=drifted_at,json=driftedAt,proto3" json:"drifted_at,omitempty"`
+}
+
+func (x *ManifestSubscribeStatus) Reset() {
+	*x = ManifestSubscribeStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManifestSubscribeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestSubscribeStatus) ProtoMessage() {}
+
+func (x *ManifestSubscribeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestSubscribeStatus.ProtoReflect.Descriptor instead.
+func (*ManifestSubscribeStatus) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ManifestSubscribeStatus) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ManifestSubscribeStatus) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ManifestSubscribeStatus) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ManifestSubscribeStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ManifestSubscribeStatus) GetDrift() []string {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+func (x *ManifestSubscribeStatus) GetDriftedAt() int64 {
+	if x != nil {
+		return x.DriftedAt
+	}
+	return 0
+}
+
+type PauseSubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PauseSubscribeRequest) Reset() {
+	*x = PauseSubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseSubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSubscribeRequest) ProtoMessage() {}
+
+func (x *PauseSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*PauseSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PauseSubscribeRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PauseSubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status   string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Entities uint64 `protobuf:"varint,3,opt,name=entities,proto3" json:"entities,omitempty"`
+}
+
+func (x *PauseSubscribeResponse) Reset() {
+	*x = PauseSubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseSubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSubscribeResponse) ProtoMessage() {}
+
+func (x *PauseSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*PauseSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *PauseSubscribeResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PauseSubscribeResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PauseSubscribeResponse) GetEntities() uint64 {
+	if x != nil {
+		return x.Entities
+	}
+	return 0
+}
+
+type ResumeSubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ResumeSubscribeRequest) Reset() {
+	*x = ResumeSubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeSubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSubscribeRequest) ProtoMessage() {}
+
+func (x *ResumeSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ResumeSubscribeRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ResumeSubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status   string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Entities uint64 `protobuf:"varint,3,opt,name=entities,proto3" json:"entities,omitempty"`
+}
+
+func (x *ResumeSubscribeResponse) Reset() {
+	*x = ResumeSubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeSubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSubscribeResponse) ProtoMessage() {}
+
+func (x *ResumeSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_subscribe_v1_subscribe_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*ResumeSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_api_subscribe_v1_subscribe_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ResumeSubscribeResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResumeSubscribeResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ResumeSubscribeResponse) GetEntities() uint64 {
+	if x != nil {
+		return x.Entities
+	}
+	return 0
+}
+
+var File_api_subscribe_v1_subscribe_proto protoreflect.FileDescriptor
+
+var file_api_subscribe_v1_subscribe_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x10, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x6f, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe5, 0xae, 0x9e, 0xe4,
+	0xbd, 0x93, 0x69, 0x64, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x22, 0xa7, 0x02, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x5f, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32,
+	0x18, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93,
+	0xe6, 0x9e, 0x9c, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x5a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa,
+	0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7,
+	0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xb9,
+	0x01, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7,
+	0xbb, 0x84, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x12, 0x48, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x32, 0x2a, 0xe5, 0x8f, 0xaa, 0xe8, 0xbf, 0x94, 0xe5, 0x9b,
+	0x9e, 0xe9, 0xa2, 0x84, 0xe8, 0xae, 0xa1, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8d, 0xe8, 0xae, 0xa2, 0xe9,
+	0x98, 0x85, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xd1, 0x03, 0x0a, 0x21, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6,
+	0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5f, 0x0a, 0x07, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x75, 0x6c, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xe7, 0xbb, 0x9f, 0xe8,
+	0xae, 0xa1, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x5a, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x20, 0x92, 0x41,
+	0x1d, 0x32, 0x1b, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7,
+	0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0xe6,
+	0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0x20, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x74, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x32,
+	0x36, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84, 0xe9, 0x85, 0x8d,
+	0xe9, 0xa2, 0x9d, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe6, 0x83, 0x85, 0xe5, 0x86, 0xb5, 0xef,
+	0xbc, 0x8c, 0xe4, 0xbb, 0x85, 0x20, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x20, 0xe6, 0x97,
+	0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0xb6,
+	0x01, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xa8, 0xa1, 0xe5, 0x9e, 0x8b, 0xe5,
+	0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x48, 0x0a,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x2f,
+	0x92, 0x41, 0x2c, 0x32, 0x2a, 0xe5, 0x8f, 0xaa, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0xa2,
+	0x84, 0xe8, 0xae, 0xa1, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93,
+	0xe6, 0x9e, 0x9c, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8d, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xd1, 0x03, 0x0a, 0x21, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5f, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0x52,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x5a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b,
+	0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0x9a, 0x84, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0xe6, 0x98, 0xaf, 0xe5,
+	0x90, 0xa6, 0xe4, 0xb8, 0xba, 0x20, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x74, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x32, 0x36, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84, 0xe9, 0x85, 0x8d, 0xe9, 0xa2, 0x9d,
+	0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe6, 0x83, 0x85, 0xe5, 0x86, 0xb5, 0xef, 0xbc, 0x8c, 0xe4,
+	0xbb, 0x85, 0x20, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x20, 0xe6, 0x97, 0xb6, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0xcb, 0x03, 0x0a, 0x0f,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x52, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x23, 0x92, 0x41, 0x20,
+	0x32, 0x1e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8e, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x95, 0xb0,
+	0x52, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x71, 0x0a, 0x18, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x37, 0x92, 0x41, 0x34, 0x32, 0x32, 0xe6, 0xaf, 0x8f, 0xe4,
+	0xb8, 0xaa, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd,
+	0x93, 0xe6, 0x95, 0xb0, 0xe4, 0xb8, 0x8a, 0xe9, 0x99, 0x90, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe8,
+	0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x8d, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0x52, 0x16,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4f, 0x0a, 0x0f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8e, 0xe7,
+	0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae,
+	0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x95, 0xb0, 0x52, 0x0e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x6b, 0x0a, 0x15, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x37, 0x92, 0x41, 0x34, 0x32, 0x32, 0xe7, 0xa7, 0x9f,
+	0xe6, 0x88, 0xb7, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4,
+	0xbd, 0x93, 0xe6, 0x95, 0xb0, 0xe4, 0xb8, 0x8a, 0xe9, 0x99, 0x90, 0xef, 0xbc, 0x8c, 0x30, 0x20,
+	0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x8d, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0x52,
+	0x13, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x98, 0xaf,
+	0xe5, 0x90, 0xa6, 0xe8, 0xb6, 0x85, 0xe5, 0x87, 0xba, 0xe9, 0x85, 0x8d, 0xe9, 0xa2, 0x9d, 0x52,
+	0x08, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x22, 0x94, 0x02, 0x0a, 0x1f, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42,
+	0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5c, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe8, 0xae, 0xbe, 0xe5,
+	0xa4, 0x87, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41,
+	0x11, 0x32, 0x0f, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2, 0xe5, 0x85, 0xb3, 0xe9, 0x94, 0xae, 0xe5,
+	0xad, 0x97, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x29, 0x92,
+	0x41, 0x26, 0x32, 0x24, 0xe5, 0x8f, 0xaa, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe5, 0x8c, 0xb9,
+	0xe9, 0x85, 0x8d, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0xef, 0xbc, 0x8c, 0xe4,
+	0xb8, 0x8d, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0x80, 0x01, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x64, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0xe5, 0x90, 0x8d, 0xe7,
+	0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6,
+	0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x9c, 0xa8, 0xe7, 0xba, 0xbf, 0x52, 0x06, 0x6f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x22, 0xdb, 0x04, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x7c, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x3a, 0x92, 0x41, 0x37,
+	0x32, 0x35, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb,
+	0x93, 0xe6, 0x9e, 0x9c, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0xef, 0xbc, 0x8c, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x20, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0xe9, 0xa2, 0x84, 0xe8, 0xae,
+	0xa1, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x77, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x38, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe5, 0xae,
+	0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93,
+	0xe6, 0x9e, 0x9c, 0xef, 0xbc, 0x8c, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x20, 0xe6, 0x97,
+	0xb6, 0xe4, 0xb8, 0xba, 0xe9, 0xa2, 0x84, 0xe8, 0xae, 0xa1, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x07, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x69, 0x65, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x14, 0x92, 0x41, 0x11,
+	0x32, 0x0f, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xbe, 0xe5, 0xa4,
+	0x87, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x16, 0x92, 0x41, 0x13,
+	0x32, 0x11, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0x20, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x74, 0x0a, 0x05, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x3b, 0x92,
+	0x41, 0x38, 0x32, 0x36, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84,
+	0xe9, 0x85, 0x8d, 0xe9, 0xa2, 0x9d, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe6, 0x83, 0x85, 0xe5,
+	0x86, 0xb5, 0xef, 0xbc, 0x8c, 0xe4, 0xbb, 0x85, 0x20, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x20, 0xe6, 0x97, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x22, 0xd3, 0x01, 0x0a, 0x1f, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x1a, 0xe5, 0x8f, 0x96,
+	0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xbe, 0xe5,
+	0xa4, 0x87, 0x49, 0x44, 0xe4, 0xbb, 0xac, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x54, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x32, 0x36, 0xe5, 0x8f, 0xaa, 0xe8, 0xbf, 0x94, 0xe5,
+	0x9b, 0x9e, 0xe9, 0xa2, 0x84, 0xe8, 0xae, 0xa1, 0xe7, 0x9a, 0x84, 0xe5, 0x8f, 0x96, 0xe6, 0xb6,
+	0x88, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xef, 0xbc, 0x8c,
+	0xe4, 0xb8, 0x8d, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xe8, 0x02, 0x0a, 0x20, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42,
+	0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
+	0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x67, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55,
+	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x8f,
+	0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c,
+	0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x60, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe5, 0xae, 0x9e,
+	0xe4, 0xbd, 0x93, 0xe7, 0x9a, 0x84, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2, 0xe9,
+	0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x2f, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4,
+	0xb8, 0xba, 0x20, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x22, 0x71, 0x0a, 0x22, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae,
+	0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0xbb, 0x84, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x23, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x67, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b,
+	0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5,
+	0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
+	0x9c, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x60, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe5, 0xae,
+	0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0x9a, 0x84, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0x6e, 0x0a, 0x22, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xa8,
+	0xa1, 0xe5, 0x9e, 0x8b, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x23, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x67, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x6e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42,
+	0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe5, 0x8f, 0x96, 0xe6,
+	0xb6, 0x88, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xe7, 0xbb,
+	0x9f, 0xe8, 0xae, 0xa1, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x60, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x26,
+	0x92, 0x41, 0x23, 0x32, 0x21, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe5, 0xae, 0x9e, 0xe4, 0xbd,
+	0x93, 0xe7, 0x9a, 0x84, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
+	0xd5, 0x04, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2f, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x14, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x20, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75,
+	0x6d, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x50, 0x61, 0x67, 0x65, 0x20,
+	0x73, 0x69, 0x7a, 0x65, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x20, 0x62, 0x79, 0xe2, 0x41, 0x01, 0x01, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
+	0x12, 0x3b, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x16, 0x92, 0x41, 0x0f, 0x32, 0x0d, 0x49, 0x73,
+	0x20, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0xe2, 0x41, 0x01, 0x01, 0x52,
+	0x0c, 0x69, 0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a,
+	0x09, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x4b, 0x65, 0x79, 0x20, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0xe2, 0x41, 0x01, 0x01, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x32,
+	0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x0c, 0x32, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20,
+	0x4b, 0x65, 0x79, 0xe2, 0x41, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b,
+	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x5a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x42, 0x92, 0x41, 0x3f, 0x32, 0x3d, 0xe6, 0x8c, 0x89, 0xe5, 0x9c, 0xa8, 0xe7, 0xba,
+	0xbf, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0xef, 0xbc, 0x9a,
+	0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0xe3, 0x80, 0x81, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0x8d, 0xe8,
+	0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x87, 0x01,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x68, 0x92, 0x41, 0x65, 0x32, 0x63, 0xe4, 0xb8, 0x8a, 0xe4, 0xb8, 0x80, 0xe9,
+	0xa1, 0xb5, 0xe5, 0x93, 0x8d, 0xe5, 0xba, 0x94, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0x20, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xef, 0xbc,
+	0x8c, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0xe6, 0x97, 0xb6, 0xe4, 0xbb, 0x8e, 0xe5, 0x85, 0xb6,
+	0xe5, 0x90, 0x8e, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe5, 0x8f, 0x96, 0x20, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x20, 0xe6, 0x9d, 0xa1, 0xef, 0xbc, 0x8c, 0xe5, 0xbf, 0xbd,
+	0xe7, 0x95, 0xa5, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x99, 0x04, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xc2, 0x01, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0xab, 0x01, 0x92, 0x41, 0xa3, 0x01,
+	0x32, 0xa0, 0x01, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x2e, 0x20, 0x57, 0x68, 0x65, 0x6e, 0x20, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x20, 0x6f, 0x72, 0x20, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x20, 0x69,
+	0x74, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x75, 0x70, 0x70, 0x65, 0x72, 0x20, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x20, 0x79,
+	0x65, 0x74, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x61, 0x73, 0x20, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20,
+	0x65, 0x78, 0x61, 0x63, 0x74, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c,
+	0x61, 0x73, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2f,
+	0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x14, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12,
+	0x2f, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x20, 0x70, 0x61,
+	0x67, 0x65, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x12, 0x2f, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x50, 0x61, 0x67, 0x65, 0x20, 0x73,
+	0x69, 0x7a, 0x65, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x3f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x5f, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x34,
+	0x32, 0x32, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0xe7, 0x9a, 0x84, 0x20, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xef, 0xbc, 0x8c, 0xe6, 0xb2, 0xa1, 0xe6,
+	0x9c, 0x89, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0xe6, 0x97, 0xb6, 0xe4, 0xb8,
+	0xba, 0xe7, 0xa9, 0xba, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xef, 0x02, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9,
+	0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15,
+	0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x12, 0x34, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe7, 0x94,
+	0xb1, 0xe6, 0xb8, 0x85, 0xe5, 0x8d, 0x95, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86, 0x52, 0x07, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0x92, 0x41, 0x22, 0x32, 0x20, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xef, 0xbc, 0x9a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x76, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7,
+	0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x82, 0x02,
+	0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9,
+	0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1a, 0x92, 0x41, 0x17,
+	0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0xe9, 0xbb, 0x98, 0xe8, 0xae,
+	0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
+	0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x82, 0x02, 0x0a, 0x17, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49,
+	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0xae, 0xa2, 0xe9,
+	0x98, 0x85, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6,
+	0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22,
+	0x37, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9,
+	0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x34, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9,
+	0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8d, 0x04, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d, 0xe7,
+	0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf,
+	0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x27, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x95, 0xb0, 0xe9, 0x87,
+	0x8f, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x17, 0x92, 0x41,
+	0x14, 0x32, 0x12, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6,
+	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x36, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1a, 0x92, 0x41,
+	0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0xe9, 0xbb, 0x98, 0xe8,
+	0xae, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
+	0xa6, 0xe7, 0x94, 0xb1, 0xe6, 0xb8, 0x85, 0xe5, 0x8d, 0x95, 0xe7, 0xae, 0xa1, 0xe7, 0x90, 0x86,
+	0x52, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0x92, 0x41, 0x22, 0x32, 0x20,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xef, 0xbc, 0x9a, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xd2, 0x03, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x14, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x20, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e,
+	0x75, 0x6d, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x50, 0x61, 0x67, 0x65,
+	0x20, 0x73, 0x69, 0x7a, 0x65, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x20, 0x62, 0x79, 0xe2, 0x41, 0x01, 0x01, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x12, 0x3b, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x16, 0x92, 0x41, 0x0f, 0x32, 0x0d, 0x49,
+	0x73, 0x20, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0xe2, 0x41, 0x01, 0x01,
+	0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2f,
+	0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x4b, 0x65, 0x79, 0x20, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0xe2, 0x41, 0x01, 0x01, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12,
+	0x32, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x0c, 0x32, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x20, 0x4b, 0x65, 0x79, 0xe2, 0x41, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x4b, 0x65, 0x79, 0x12, 0x87, 0x01, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x68, 0x92, 0x41, 0x65, 0x32, 0x63, 0xe4,
+	0xb8, 0x8a, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0xe5, 0x93, 0x8d, 0xe5, 0xba, 0x94, 0xe4, 0xb8,
+	0xad, 0xe7, 0x9a, 0x84, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0xef, 0xbc, 0x8c, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0xe6, 0x97, 0xb6,
+	0xe4, 0xbb, 0x8e, 0xe5, 0x85, 0xb6, 0xe5, 0x90, 0x8e, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe5,
+	0x8f, 0x96, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x20, 0xe6, 0x9d, 0xa1,
+	0xef, 0xbc, 0x8c, 0xe5, 0xbf, 0xbd, 0xe7, 0x95, 0xa5, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe8, 0x02,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0x92, 0x41, 0x07, 0x32, 0x05, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2f, 0x0a,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x14, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2f,
+	0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x20, 0x70, 0x61, 0x67,
+	0x65, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x2f, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x50, 0x61, 0x67, 0x65, 0x20, 0x73, 0x69,
+	0x7a, 0x65, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x35, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5f, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0x92, 0x41, 0x34, 0x32, 0x32, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5,
+	0xe7, 0x9a, 0x84, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xef, 0xbc,
+	0x8c, 0xe6, 0xb2, 0xa1, 0xe6, 0x9c, 0x89, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5,
+	0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8e, 0x03, 0x0a, 0x17, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0x92, 0x41, 0x10,
+	0x32, 0x0e, 0xe7, 0x9b, 0xae, 0xe6, 0xa0, 0x87, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44,
+	0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x19, 0x92, 0x41, 0x16, 0x32, 0x14, 0xe8, 0xa2, 0xab, 0xe7, 0xa7, 0xbb, 0xe5, 0x8a, 0xa8, 0xe7,
+	0x9a, 0x84, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0x49, 0x44, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x48, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x32, 0x2a, 0xe5,
+	0x8f, 0xaa, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe9, 0xa2, 0x84, 0xe8, 0xae, 0xa1, 0xe7, 0x9a,
+	0x84, 0xe7, 0xa7, 0xbb, 0xe5, 0x8a, 0xa8, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xef, 0xbc, 0x8c,
+	0xe4, 0xb8, 0x8d, 0xe7, 0xa7, 0xbb, 0xe5, 0x8a, 0xa8, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x12, 0x42, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2e, 0x92, 0x41, 0x2b, 0x32, 0x29, 0x6d, 0x6f, 0x76, 0x65, 0xef, 0xbc, 0x9a, 0xe7, 0xa7, 0xbb,
+	0xe5, 0x8a, 0xa8, 0xef, 0xbc, 0x88, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xef, 0xbc, 0x89, 0xef,
+	0xbc, 0x8c, 0x63, 0x6f, 0x70, 0x79, 0xef, 0xbc, 0x9a, 0xe5, 0xa4, 0x8d, 0xe5, 0x88, 0xb6, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x52, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x5f,
+	0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x2c, 0x92,
+	0x41, 0x29, 0x32, 0x27, 0xe6, 0x9c, 0x89, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x97, 0xa0,
+	0xe6, 0xb3, 0x95, 0xe7, 0xa7, 0xbb, 0xe5, 0x8a, 0xa8, 0xe6, 0x97, 0xb6, 0xe5, 0x85, 0xa8, 0xe9,
+	0x83, 0xa8, 0xe4, 0xb8, 0x8d, 0xe7, 0xa7, 0xbb, 0xe5, 0x8a, 0xa8, 0x52, 0x0c, 0x61, 0x6c, 0x6c,
+	0x4f, 0x72, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0xde, 0x02, 0x0a, 0x18, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xaf, 0xb7,
+	0xe6, 0xb1, 0x82, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x5a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe5,
+	0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe7, 0x9a, 0x84, 0xe7, 0xa7, 0xbb, 0xe5, 0x8a, 0xa8, 0xe7, 0xbb,
+	0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2f, 0x0a,
+	0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x16,
+	0x92, 0x41, 0x13, 0x32, 0x11, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0x20, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x89,
+	0x01, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x32, 0x4b, 0xe7, 0xa7, 0xbb, 0xe5, 0x8a, 0xa8, 0xe6, 0x88,
+	0x96, 0xe5, 0xa4, 0x8d, 0xe5, 0x88, 0xb6, 0xe5, 0x90, 0x8e, 0xe7, 0x9b, 0xae, 0xe6, 0xa0, 0x87,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe9, 0x85, 0x8d, 0xe9, 0xa2, 0x9d, 0xe4,
+	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe6, 0x83, 0x85, 0xe5, 0x86, 0xb5, 0xef, 0xbc, 0x8c, 0xe4, 0xbb,
+	0x85, 0x20, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x20, 0xe6, 0x97, 0xb6, 0xe8, 0xbf, 0x94,
+	0xe5, 0x9b, 0x9e, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0xb0, 0x02, 0x0a, 0x06, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x69,
+	0x64, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x09, 0x92, 0x41, 0x06, 0x32, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x32, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2f, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x44, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1f,
+	0x92, 0x41, 0x1c, 0x32, 0x1a, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
+	0xb4, 0xef, 0xbc, 0x88, 0x55, 0x6e, 0x69, 0x78, 0x20, 0xe7, 0xa7, 0x92, 0xef, 0xbc, 0x89, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3d, 0x0a,
+	0x19, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x92, 0x41, 0x07, 0x32, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x41, 0x0a, 0x1a,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x73, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0d, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x12, 0x92, 0x41, 0x0f, 0x32, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x20, 0x69, 0x64, 0x73, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x49, 0x64, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x66, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x32, 0x27, 0xe8,
+	0xae, 0xbe, 0xe5, 0xa4, 0x87, 0xe5, 0x9c, 0xa8, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x6b, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x29, 0x92, 0x41, 0x26, 0x32, 0x24, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xe7, 0xbb, 0x9f, 0xe8, 0xae,
+	0xa1, 0xef, 0xbc, 0x8c, 0xe6, 0x8c, 0x89, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe8, 0xae, 0xa1,
+	0xe6, 0x95, 0xb0, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0xae, 0x01, 0x0a,
+	0x1f, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41,
+	0x0b, 0x32, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x6b, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x46, 0x92, 0x41, 0x43, 0x32, 0x41, 0xe8, 0xae,
+	0xbe, 0xe5, 0xa4, 0x87, 0xe6, 0x9c, 0x80, 0xe7, 0xbb, 0x88, 0xe6, 0x89, 0x80, 0xe5, 0xb1, 0x9e,
+	0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe4, 0xb8,
+	0xba, 0xe7, 0xa9, 0xba, 0xe6, 0x97, 0xb6, 0xe4, 0xbb, 0x8e, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe4, 0xb8, 0xad, 0xe7, 0xa7, 0xbb, 0xe9, 0x99, 0xa4, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x64, 0x73, 0x22, 0xcd, 0x01,
+	0x0a, 0x20, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x49, 0x92, 0x41, 0x46, 0x32, 0x44,
+	0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0xe5, 0x9c, 0xa8, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe7, 0xbb, 0x93, 0xe6, 0x9e,
+	0x9c, 0xef, 0xbc, 0x9a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0xe3, 0x80, 0x81, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0xe3, 0x80, 0x81, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x20, 0xe7, 0xad, 0x89, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x42, 0x0a,
+	0x20, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92,
+	0x41, 0x0b, 0x32, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x20, 0x69, 0x64, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x79, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe5, 0x8c,
+	0x85, 0xe5, 0x90, 0xab, 0xe8, 0xaf, 0xa5, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0xe7, 0x9a, 0x84,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc6, 0x04, 0x0a,
+	0x1e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2f, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x14, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x20, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d,
+	0x12, 0x2f, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x50, 0x61, 0x67, 0x65, 0x20, 0x73,
+	0x69, 0x7a, 0x65, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x20,
+	0x62, 0x79, 0xe2, 0x41, 0x01, 0x01, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12,
+	0x3b, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x16, 0x92, 0x41, 0x0f, 0x32, 0x0d, 0x49, 0x73, 0x20,
+	0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0xe2, 0x41, 0x01, 0x01, 0x52, 0x0c,
+	0x69, 0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x09,
+	0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x4b, 0x65, 0x79, 0x20, 0x77, 0x6f, 0x72, 0x64, 0x73, 0xe2,
+	0x41, 0x01, 0x01, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x32, 0x0a,
+	0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x13, 0x92, 0x41, 0x0c, 0x32, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x4b,
+	0x65, 0x79, 0xe2, 0x41, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b, 0x65,
+	0x79, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x49, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x31, 0x92, 0x41, 0x2e, 0x32, 0x2c, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0x8a, 0xb6,
+	0xe6, 0x80, 0x81, 0x3a, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x72, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x64, 0x6f, 0x6e, 0x65, 0x2c, 0x20, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x68, 0x92, 0x41, 0x65, 0x32, 0x63, 0xe4, 0xb8, 0x8a, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5,
+	0xe5, 0x93, 0x8d, 0xe5, 0xba, 0x94, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0x20, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xef, 0xbc, 0x8c, 0xe8,
+	0xae, 0xbe, 0xe7, 0xbd, 0xae, 0xe6, 0x97, 0xb6, 0xe4, 0xbb, 0x8e, 0xe5, 0x85, 0xb6, 0xe5, 0x90,
+	0x8e, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe5, 0x8f, 0x96, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x20, 0xe6, 0x9d, 0xa1, 0xef, 0xbc, 0x8c, 0xe5, 0xbf, 0xbd, 0xe7, 0x95,
+	0xa5, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x94, 0x03, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0x92, 0x41, 0x07, 0x32, 0x05, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x2f, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x14, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x20, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d,
+	0x12, 0x2f, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x20, 0x70,
+	0x61, 0x67, 0x65, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x50, 0x61, 0x67, 0x65, 0x20,
+	0x73, 0x69, 0x7a, 0x65, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x57, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe5, 0x90, 0x8c,
+	0xe6, 0xad, 0xa5, 0xe5, 0x88, 0xb0, 0x20, 0x63, 0x6f, 0x72, 0x65, 0x20, 0xe7, 0x9a, 0x84, 0xe6,
+	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5f, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x34, 0x32, 0x32, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8,
+	0x80, 0xe9, 0xa1, 0xb5, 0xe7, 0x9a, 0x84, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0xef, 0xbc, 0x8c, 0xe6, 0xb2, 0xa1, 0xe6, 0x9c, 0x89, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8,
+	0x80, 0xe9, 0xa1, 0xb5, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbb, 0x03, 0x0a,
+	0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0x49, 0x44, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe4,
+	0xbd, 0x93, 0x49, 0x44, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x41,
+	0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0x3a, 0x20,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2c, 0x20, 0x75, 0x6e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x31, 0x92, 0x41, 0x2e, 0x32, 0x2c, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0x8a,
+	0xb6, 0xe6, 0x80, 0x81, 0x3a, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x72,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x64, 0x6f, 0x6e, 0x65, 0x2c, 0x20, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x14,
+	0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xb7, 0xb2, 0xe5, 0xb0, 0x9d, 0xe8, 0xaf, 0x95, 0xe6, 0xac,
+	0xa1, 0xe6, 0x95, 0xb0, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x36,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4,
+	0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0x52, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe6, 0x02, 0x0a, 0x14, 0x42,
+	0x75, 0x6c, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe5, 0x8e, 0xbb, 0xe9, 0x87, 0x8d, 0xe5,
+	0x90, 0x8e, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x80, 0xbb, 0xe6, 0x95,
+	0xb0, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15,
+	0xe6, 0x96, 0xb0, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4,
+	0xbd, 0x93, 0xe6, 0x95, 0xb0, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x36,
+	0x0a, 0x08, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe5, 0xb7, 0xb2, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x95, 0xb0, 0x52, 0x08, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x35, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4,
+	0xbd, 0x93, 0xe6, 0x95, 0xb0, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x37, 0x0a,
+	0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x9c, 0xaa, 0xe6, 0x89, 0xbe, 0xe5, 0x88, 0xb0,
+	0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x95, 0xb0, 0x52, 0x08, 0x6e, 0x6f,
+	0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18,
+	0xe6, 0x97, 0xa0, 0xe6, 0x9d, 0x83, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5,
+	0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x95, 0xb0, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x22, 0xcc, 0x01, 0x0a, 0x16, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x33,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0x92,
+	0x41, 0x1a, 0x32, 0x18, 0xe5, 0x8e, 0xbb, 0xe9, 0x87, 0x8d, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84,
+	0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe5, 0x8f, 0x96, 0xe6, 0xb6,
+	0x88, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
+	0xe6, 0x95, 0xb0, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x0e,
+	0x6e, 0x6f, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0x9c, 0xaa, 0xe8, 0xa2,
+	0xab, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
+	0xe6, 0x95, 0xb0, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x64, 0x22, 0x8f, 0x02, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e,
+	0xe4, 0xbd, 0x93, 0x49, 0x44, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12,
+	0x76, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x5e, 0x92, 0x41, 0x5b, 0x32, 0x59, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xef, 0xbc, 0x9a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0xe3, 0x80, 0x81, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0xe3, 0x80, 0x81, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0xe3, 0x80, 0x81, 0x6e, 0x6f,
+	0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0xe3, 0x80, 0x81, 0x6e,
+	0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0xe3, 0x80, 0x81, 0x66, 0x6f, 0x72, 0x62, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xa4,
+	0xb1, 0xe8, 0xb4, 0xa5, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0xc6, 0x02, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x95, 0xb0, 0x52, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe7,
+	0xad, 0x89, 0xe5, 0xbe, 0x85, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe5, 0x88, 0xb0, 0x20, 0x63,
+	0x6f, 0x72, 0x65, 0x20, 0xe7, 0x9a, 0x84, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe6, 0x95, 0xb0,
+	0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x59, 0x0a, 0x04, 0x64, 0x6f, 0x6e,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x45, 0x92, 0x41, 0x42, 0x32, 0x40, 0xe4, 0xbf,
+	0x9d, 0xe7, 0x95, 0x99, 0xe6, 0x9c, 0x9f, 0xef, 0xbc, 0x88, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x58,
+	0x5f, 0x52, 0x45, 0x54, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0xef, 0xbc, 0x89, 0xe5, 0x86, 0x85,
+	0xe5, 0xb7, 0xb2, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe5, 0x88, 0xb0, 0x20, 0x63, 0x6f, 0x72,
+	0x65, 0x20, 0xe7, 0x9a, 0x84, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe6, 0x95, 0xb0, 0x52, 0x04,
+	0x64, 0x6f, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe5, 0x90, 0x8c, 0xe6, 0xad,
+	0xa5, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe7, 0x9a, 0x84, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c,
+	0xe6, 0x95, 0xb0, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xfc,
+	0x03, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe5, 0xbd,
+	0x93, 0xe5, 0x89, 0x8d, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0xe6, 0x95, 0xb0, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x73, 0x12, 0x62, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x37, 0x92, 0x41,
+	0x34, 0x32, 0x32, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7,
+	0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x95, 0xb0, 0xe4, 0xb8, 0x8a, 0xe9, 0x99,
+	0x90, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x8d, 0xe9,
+	0x99, 0x90, 0xe5, 0x88, 0xb6, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x78, 0x0a, 0x1c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x37, 0x92, 0x41,
+	0x34, 0x32, 0x32, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7,
+	0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x95, 0xb0, 0xe4, 0xb8, 0x8a, 0xe9, 0x99,
+	0x90, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xb8, 0x8d, 0xe9,
+	0x99, 0x90, 0xe5, 0x88, 0xb6, 0x52, 0x19, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x50,
+	0x65, 0x72, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x52, 0x0a, 0x0f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x29, 0x92, 0x41, 0x26, 0x32, 0x24,
+	0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe6, 0x89, 0x80, 0xe6,
+	0x9c, 0x89, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd,
+	0x93, 0xe6, 0x95, 0xb0, 0x52, 0x0e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x77, 0x0a, 0x15, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x43, 0x92, 0x41, 0x40, 0x32, 0x3e, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa,
+	0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe8, 0xae, 0xa2, 0xe9,
+	0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x95, 0xb0, 0xe4, 0xb8,
+	0x8a, 0xe9, 0x99, 0x90, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4,
+	0xb8, 0x8d, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0x52, 0x13, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xa5, 0x06,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0x92, 0x41, 0x0d, 0x32,
+	0x0b, 0x50, 0x61, 0x67, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x12, 0x92, 0x41,
+	0x0b, 0x32, 0x09, 0x50, 0x61, 0x67, 0x65, 0x20, 0x73, 0x69, 0x7a, 0x65, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x62, 0x79, 0xe2, 0x41, 0x01, 0x01, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x3b, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x16, 0x92, 0x41, 0x0f, 0x32, 0x0d, 0x49, 0x73, 0x20, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0xe2, 0x41, 0x01, 0x01, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x4b,
+	0x65, 0x79, 0x20, 0x77, 0x6f, 0x72, 0x64, 0x73, 0xe2, 0x41, 0x01, 0x01, 0x52, 0x08, 0x6b, 0x65,
+	0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x92, 0x41, 0x0c, 0x32,
+	0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x4b, 0x65, 0x79, 0xe2, 0x41, 0x01, 0x01, 0x52,
+	0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0xe6, 0x8c, 0x89, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0x49, 0x44, 0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0xe6,
+	0x8c, 0x89, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x49, 0x44, 0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4,
+	0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15,
+	0xe6, 0x8c, 0x89, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe8,
+	0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x56, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x37, 0x92, 0x41, 0x34, 0x32, 0x32, 0xe8, 0xb5, 0xb7, 0xe5, 0xa7, 0x8b, 0xe6, 0x97, 0xb6,
+	0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x88, 0xe5, 0x90, 0xab, 0xef, 0xbc, 0x89, 0xef, 0xbc, 0x8c, 0x55,
+	0x6e, 0x69, 0x78, 0x20, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0x88, 0xb3, 0xef, 0xbc, 0x8c,
+	0xe5, 0x8d, 0x95, 0xe4, 0xbd, 0x8d, 0xe7, 0xa7, 0x92, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x55, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x42, 0x3a, 0x92, 0x41, 0x37, 0x32, 0x35, 0xe7, 0xbb, 0x93,
+	0xe6, 0x9d, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x88, 0xe4, 0xb8, 0x8d, 0xe5,
+	0x90, 0xab, 0xef, 0xbc, 0x89, 0xef, 0xbc, 0x8c, 0x55, 0x6e, 0x69, 0x78, 0x20, 0xe6, 0x97, 0xb6,
+	0xe9, 0x97, 0xb4, 0xe6, 0x88, 0xb3, 0xef, 0xbc, 0x8c, 0xe5, 0x8d, 0x95, 0xe4, 0xbd, 0x8d, 0xe7,
+	0xa7, 0x92, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x68, 0x92, 0x41, 0x65, 0x32, 0x63, 0xe4, 0xb8, 0x8a, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5,
+	0xe5, 0x93, 0x8d, 0xe5, 0xba, 0x94, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0x20, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xef, 0xbc, 0x8c, 0xe8,
+	0xae, 0xbe, 0xe7, 0xbd, 0xae, 0xe6, 0x97, 0xb6, 0xe4, 0xbb, 0x8e, 0xe5, 0x85, 0xb6, 0xe5, 0x90,
+	0x8e, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe5, 0x8f, 0x96, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x20, 0xe6, 0x9d, 0xa1, 0xef, 0xbc, 0x8c, 0xe5, 0xbf, 0xbd, 0xe7, 0x95,
+	0xa5, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8a, 0x03, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0e, 0x92, 0x41, 0x07, 0x32, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0x92, 0x41, 0x0d, 0x32, 0x0b,
+	0x50, 0x61, 0x67, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2f, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x12, 0x92, 0x41, 0x0b,
+	0x32, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x12, 0x92, 0x41,
+	0x0b, 0x32, 0x09, 0x50, 0x61, 0x67, 0x65, 0x20, 0x73, 0x69, 0x7a, 0x65, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x55, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe5, 0xae, 0xa1,
+	0xe8, 0xae, 0xa1, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xef, 0xbc, 0x8c, 0xe6, 0x8c, 0x89, 0xe6,
+	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe5, 0x80, 0x92, 0xe5, 0xba, 0x8f, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x5f, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x34, 0x32,
+	0x32, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0xe7, 0x9a, 0x84, 0x20, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xef, 0xbc, 0x8c, 0xe6, 0xb2, 0xa1, 0xe6, 0x9c,
+	0x89, 0xe4, 0xb8, 0x8b, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba,
+	0xe7, 0xa9, 0xba, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xa8, 0x04, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x27, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0x94, 0xa8, 0xe6,
+	0x88, 0xb7, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x4f, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x34, 0x32, 0x32,
+	0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xef, 0xbc, 0x8c, 0xe5, 0xa6, 0x82, 0x20, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0xe3, 0x80, 0x81,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52,
+	0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x08,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x16,
+	0x92, 0x41, 0x13, 0x32, 0x11, 0xe6, 0xb6, 0x89, 0xe5, 0x8f, 0x8a, 0xe7, 0x9a, 0x84, 0xe5, 0xae,
+	0x9e, 0xe4, 0xbd, 0x93, 0x49, 0x44, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x33, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1b, 0x92, 0x41, 0x18, 0x32, 0x16, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe5, 0x89, 0x8d,
+	0xe7, 0x9a, 0x84, 0xe5, 0x80, 0xbc, 0xef, 0xbc, 0x8c, 0x4a, 0x53, 0x4f, 0x4e, 0x52, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x32, 0x16, 0xe6, 0x93, 0x8d, 0xe4, 0xbd,
+	0x9c, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84, 0xe5, 0x80, 0xbc, 0xef, 0xbc, 0x8c, 0x4a, 0x53, 0x4f,
+	0x4e, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x32, 0x1c,
+	0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xef, 0xbc, 0x8c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x20, 0xe6, 0x88, 0x96, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4,
+	0xa5, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x2e, 0x92, 0x41, 0x2b, 0x32, 0x29, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe6,
+	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0x55, 0x6e, 0x69, 0x78, 0x20, 0xe6, 0x97, 0xb6,
+	0xe9, 0x97, 0xb4, 0xe6, 0x88, 0xb3, 0xef, 0xbc, 0x8c, 0xe5, 0x8d, 0x95, 0xe4, 0xbd, 0x8d, 0xe7,
+	0xa7, 0x92, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xda, 0x03,
+	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f,
+	0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x14, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12,
+	0x2f, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x50, 0x61, 0x67, 0x65, 0x20, 0x73, 0x69,
+	0x7a, 0x65, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0a, 0x32, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x62,
+	0x79, 0xe2, 0x41, 0x01, 0x01, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x3b,
+	0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x16, 0x92, 0x41, 0x0f, 0x32, 0x0d, 0x49, 0x73, 0x20, 0x64,
+	0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0xe2, 0x41, 0x01, 0x01, 0x52, 0x0c, 0x69,
+	0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x09, 0x6b,
+	0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12,
+	0x92, 0x41, 0x0b, 0x32, 0x09, 0x4b, 0x65, 0x79, 0x20, 0x77, 0x6f, 0x72, 0x64, 0x73, 0xe2, 0x41,
+	0x01, 0x01, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x0a,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x13, 0x92, 0x41, 0x0c, 0x32, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x4b, 0x65,
+	0x79, 0xe2, 0x41, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4b, 0x65, 0x79,
+	0x12, 0x87, 0x01, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x68, 0x92, 0x41, 0x65, 0x32, 0x63, 0xe4, 0xb8, 0x8a, 0xe4,
+	0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0xe5, 0x93, 0x8d, 0xe5, 0xba, 0x94, 0xe4, 0xb8, 0xad, 0xe7, 0x9a,
+	0x84, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0xef, 0xbc, 0x8c, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0xe6, 0x97, 0xb6, 0xe4, 0xbb, 0x8e,
+	0xe5, 0x85, 0xb6, 0xe5, 0x90, 0x8e, 0xe5, 0xbc, 0x80, 0xe5, 0xa7, 0x8b, 0xe5, 0x8f, 0x96, 0x20,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x20, 0xe6, 0x9d, 0xa1, 0xef, 0xbc, 0x8c,
+	0xe5, 0xbf, 0xbd, 0xe7, 0x95, 0xa5, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa2, 0x03, 0x0a, 0x1d, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0x92, 0x41, 0x07,
+	0x32, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x20,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65,
+	0x4e, 0x75, 0x6d, 0x12, 0x2f, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x4c, 0x61, 0x73,
+	0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x12, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x50, 0x61,
+	0x67, 0x65, 0x20, 0x73, 0x69, 0x7a, 0x65, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x67, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x32, 0x2a, 0xe5, 0xb7,
+	0xb2, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0xef, 0xbc, 0x8c, 0xe6, 0x8c, 0x89, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe6, 0x97, 0xb6, 0xe9,
+	0x97, 0xb4, 0xe5, 0x80, 0x92, 0xe5, 0xba, 0x8f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5f,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x34, 0x32, 0x32, 0xe4, 0xb8,
+	0x8b, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0xe7, 0x9a, 0x84, 0x20, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xef, 0xbc, 0x8c, 0xe6, 0xb2, 0xa1, 0xe6, 0x9c, 0x89, 0xe4, 0xb8,
+	0x8b, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xf1, 0x03, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6,
+	0xa0, 0x87, 0xe9, 0xa2, 0x98, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f,
+	0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
+	0xa6, 0xe4, 0xb8, 0xba, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0x92,
+	0x41, 0x1d, 0x32, 0x1b, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe6, 0x97, 0xb6, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x95, 0xb0, 0x52,
+	0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2e, 0x92,
+	0x41, 0x2b, 0x32, 0x29, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+	0xef, 0xbc, 0x8c, 0x55, 0x6e, 0x69, 0x78, 0x20, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0x88,
+	0xb3, 0xef, 0xbc, 0x8c, 0xe5, 0x8d, 0x95, 0xe4, 0xbd, 0x8d, 0xe7, 0xa7, 0x92, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x69, 0x0a, 0x08, 0x70, 0x75, 0x72, 0x67,
+	0x65, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x4e, 0x92, 0x41, 0x4b, 0x32,
+	0x49, 0xe5, 0xbd, 0xbb, 0xe5, 0xba, 0x95, 0xe6, 0xb8, 0x85, 0xe9, 0x99, 0xa4, 0xe7, 0x9a, 0x84,
+	0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0x55, 0x6e, 0x69, 0x78, 0x20, 0xe6, 0x97,
+	0xb6, 0xe9, 0x97, 0xb4, 0xe6, 0x88, 0xb3, 0xef, 0xbc, 0x8c, 0xe5, 0x8d, 0x95, 0xe4, 0xbd, 0x8d,
+	0xe7, 0xa7, 0x92, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0xb0,
+	0xb8, 0xe4, 0xb9, 0x85, 0xe4, 0xbf, 0x9d, 0xe7, 0x95, 0x99, 0x52, 0x07, 0x70, 0x75, 0x72, 0x67,
+	0x65, 0x41, 0x74, 0x22, 0x38, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
+	0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbc, 0x02,
+	0x0a, 0x18, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0xa0, 0x87, 0xe9, 0xa2, 0x98, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0x52, 0x08, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32,
+	0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x12, 0x39, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6,
+	0x95, 0xb0, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x82, 0x01, 0x0a,
+	0x11, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe6, 0x93,
+	0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0xac, 0xa6, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x1e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0x92, 0x41, 0x05, 0x32, 0x03, 0xe5, 0x80, 0xbc, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xbe, 0x04, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9,
+	0xe5, 0x99, 0xa8, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x0b,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x92, 0x41, 0x24, 0x32, 0x22,
+	0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x92, 0x41, 0x15, 0x32, 0x13, 0xe5, 0x88,
+	0x86, 0xe7, 0xbb, 0x84, 0x49, 0x44, 0xe6, 0x88, 0x96, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x49,
+	0x44, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6,
+	0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0xe5, 0xad, 0x90, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0x52,
+	0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x75, 0x62, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x12, 0x5c, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32,
+	0x12, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x9d, 0xa1,
+	0xe4, 0xbb, 0xb6, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x3c, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0xe5, 0x99,
+	0xa8, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93,
+	0xe6, 0x95, 0xb0, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x4b, 0x0a,
+	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x25, 0x92, 0x41, 0x22, 0x32, 0x20, 0xe4, 0xb8, 0x8a, 0xe6,
+	0xac, 0xa1, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc,
+	0x88, 0x55, 0x6e, 0x69, 0x78, 0x20, 0xe7, 0xa7, 0x92, 0xef, 0xbc, 0x89, 0x52, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17,
+	0x92, 0x41, 0x14, 0x32, 0x12, 0xe4, 0xb8, 0x8a, 0xe6, 0xac, 0xa1, 0xe5, 0x90, 0x8c, 0xe6, 0xad,
+	0xa5, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0xdd, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x31, 0x0a, 0x0b, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x10,
+	0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0x49, 0x44,
+	0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x08,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x17,
+	0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x96, 0xb0, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a,
+	0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x52, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88,
+	0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x52,
+	0x08, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5,
+	0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0xd3, 0x02, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x27, 0x92, 0x41, 0x24, 0x32, 0x22, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef,
+	0xbc, 0x9a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0x92, 0x41, 0x15, 0x32, 0x13, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0x49, 0x44, 0xe6,
+	0x88, 0x96, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x49, 0x44, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x47, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x75, 0x62,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1a, 0x92, 0x41,
+	0x17, 0x32, 0x15, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0xe5,
+	0xad, 0x90, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x53, 0x75, 0x62, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x5c, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe8, 0xae, 0xbe, 0xe5, 0xa4, 0x87,
+	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x1f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x08,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9,
+	0xe5, 0x99, 0xa8, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x51, 0x0a,
+	0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe9, 0xa6, 0x96, 0xe6, 0xac, 0xa1, 0xe5, 0x90,
+	0x8c, 0xe6, 0xad, 0xa5, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x63,
+	0x22, 0x3e, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x6f, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe9, 0x80, 0x89, 0xe6,
+	0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x72, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x31, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe9, 0x80,
+	0x89, 0xe6, 0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9,
+	0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x54, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x1a, 0x92, 0x41,
+	0x17, 0x32, 0x15, 0xe5, 0x8f, 0x96, 0xe6, 0xb6, 0x88, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7,
+	0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x3e,
+	0x0a, 0x1d, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa1,
+	0x01, 0x0a, 0x1e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x60, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x79, 0x6e,
+	0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe5, 0x90,
+	0x84, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0xe7, 0x9a, 0x84, 0xe5, 0x90, 0x8c,
+	0xe6, 0xad, 0xa5, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x58,
+	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x46, 0x92, 0x41, 0x43,
+	0x32, 0x41, 0xe8, 0xa6, 0x81, 0xe5, 0xaf, 0xbc, 0xe5, 0x87, 0xba, 0xe7, 0x9a, 0x84, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe6,
+	0x97, 0xb6, 0xe5, 0xaf, 0xbc, 0xe5, 0x87, 0xba, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe7, 0x94,
+	0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0x9a, 0x84, 0xe5, 0x85, 0xa8, 0xe9, 0x83, 0xa8, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x32, 0x2a, 0xe6,
+	0x96, 0x87, 0xe6, 0xa1, 0xa3, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xef, 0xbc, 0x9a, 0x6a, 0x73,
+	0x6f, 0x6e, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0xef, 0xbc, 0x8c, 0xe9, 0xbb,
+	0x98, 0xe8, 0xae, 0xa4, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x22, 0xe1, 0x01, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x96, 0x87, 0xe6, 0xa1, 0xa3, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc,
+	0x8f, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x6b, 0x0a, 0x08, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4f, 0x92, 0x41, 0x4c,
+	0x32, 0x4a, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x96, 0x87, 0xe6, 0xa1, 0xa3, 0xef, 0xbc,
+	0x8c, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0xe6, 0xa0, 0x87, 0xe9, 0xa2, 0x98, 0xe3, 0x80, 0x81,
+	0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0xe3, 0x80, 0x81, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0xe5,
+	0x99, 0xa8, 0xe5, 0x92, 0x8c, 0xe6, 0x89, 0x8b, 0xe5, 0x8a, 0xa8, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0x49, 0x44, 0x52, 0x08, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0xaf, 0xbc, 0xe5,
+	0x87, 0xba, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x95, 0xb0, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe4, 0x01, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x56, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x3a, 0x92, 0x41, 0x37, 0x32, 0x35, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x20, 0xe5, 0xaf, 0xbc, 0xe5, 0x87,
+	0xba, 0xe7, 0x9a, 0x84, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x59, 0x41,
+	0x4d, 0x4c, 0x20, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x96, 0x87, 0xe6, 0xa1, 0xa3, 0x52,
+	0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x71, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x55, 0x92, 0x41, 0x52,
+	0x32, 0x50, 0xe5, 0xb7, 0xb2, 0xe6, 0x9c, 0x89, 0xe5, 0x90, 0x8c, 0xe5, 0x90, 0x8d, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0xe6, 0x97, 0xb6, 0xe7, 0x9a, 0x84, 0xe5, 0xa4, 0x84, 0xe7, 0x90, 0x86,
+	0xe6, 0x96, 0xb9, 0xe5, 0xbc, 0x8f, 0xef, 0xbc, 0x9a, 0x73, 0x6b, 0x69, 0x70, 0xe3, 0x80, 0x81,
+	0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x72, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0xef, 0xbc, 0x8c, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0x20, 0x73, 0x6b,
+	0x69, 0x70, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x22, 0xe0, 0x04, 0x0a,
+	0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0x96, 0x87, 0xe6,
+	0xa1, 0xa3, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0xa0,
+	0x87, 0xe9, 0xa2, 0x98, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x14, 0xe5, 0xaf,
+	0xbc, 0xe5, 0x85, 0xa5, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x66, 0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f,
+	0x92, 0x41, 0x3c, 0x32, 0x3a, 0xe5, 0xaf, 0xbc, 0xe5, 0x85, 0xa5, 0xe5, 0x90, 0x8e, 0xe7, 0x9a,
+	0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0xa0, 0x87, 0xe9, 0xa2, 0x98, 0xef, 0xbc, 0x8c,
+	0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0x8e, 0xe6, 0x96, 0x87,
+	0xe6, 0xa1, 0xa3, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0x8d, 0xe5, 0x90, 0x8c, 0x52,
+	0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x5a,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42,
+	0x92, 0x41, 0x3f, 0x32, 0x3d, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xef, 0xbc, 0x9a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0xe3, 0x80, 0x81, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0xe3,
+	0x80, 0x81, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0xe3, 0x80, 0x81,
+	0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x8b, 0x01, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x42, 0x40, 0x92, 0x41, 0x3d, 0x32, 0x3b, 0xe6, 0x9c, 0xaa, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xef, 0xbc, 0x9a, 0x63, 0x6f, 0x72, 0x65,
+	0x20, 0xe4, 0xb8, 0xad, 0xe6, 0x9c, 0xaa, 0xe6, 0x89, 0xbe, 0xe5, 0x88, 0xb0, 0xe6, 0x88, 0x96,
+	0xe5, 0xb1, 0x9e, 0xe4, 0xba, 0x8e, 0xe5, 0x85, 0xb6, 0xe4, 0xbb, 0x96, 0xe7, 0x94, 0xa8, 0xe6,
+	0x88, 0xb7, 0x52, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x6a, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x26, 0x92, 0x41,
+	0x23, 0x32, 0x21, 0xe5, 0xaf, 0xbc, 0xe5, 0x85, 0xa5, 0xe7, 0x9a, 0x84, 0xe9, 0x80, 0x89, 0xe6,
+	0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0xe7, 0x9a, 0x84, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe7, 0xbb,
+	0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22,
+	0xc3, 0x01, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0x92, 0x41,
+	0x27, 0x32, 0x25, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0xe3, 0x80, 0x81, 0x50, 0x41, 0x52,
+	0x54, 0x49, 0x41, 0x4c, 0x20, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x20, 0xe6, 0x88, 0x96,
+	0x20, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x63, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32,
+	0x1b, 0xe6, 0xaf, 0x8f, 0xe4, 0xb8, 0xaa, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84,
+	0xe5, 0xaf, 0xbc, 0xe5, 0x85, 0xa5, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x79, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x09, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x20, 0x92, 0x41, 0x1d,
+	0x32, 0x1b, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe7, 0x9a,
+	0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6, 0xb8, 0x85, 0xe5, 0x8d, 0x95, 0x52, 0x09, 0x6d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x22, 0xca, 0x02, 0x0a, 0x0e, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe6, 0xb8, 0x85, 0xe5, 0x8d, 0x95, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0x52, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0xb8, 0x85, 0xe5, 0x8d, 0x95, 0xe5, 0xa3, 0xb0,
+	0xe6, 0x98, 0x8e, 0xe7, 0x9a, 0x84, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x9c, 0x80,
+	0xe8, 0xbf, 0x91, 0xe5, 0xba, 0x94, 0xe7, 0x94, 0xa8, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52,
+	0x09, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b,
+	0xe6, 0xb8, 0x85, 0xe5, 0x8d, 0x95, 0xe8, 0xaf, 0xbb, 0xe5, 0x8f, 0x96, 0xe6, 0x88, 0x96, 0xe8,
+	0xa7, 0xa3, 0xe6, 0x9e, 0x90, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x71, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe6, 0xb8, 0x85, 0xe5, 0x8d, 0x95, 0xe4, 0xb8,
+	0xad, 0xe5, 0x90, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0xba, 0x94,
+	0xe7, 0x94, 0xa8, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x73, 0x22, 0xf4, 0x02, 0x0a, 0x17, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe5, 0x90, 0x8d,
+	0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x54, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x32, 0x37,
+	0xe5, 0xba, 0x94, 0xe7, 0x94, 0xa8, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xef, 0xbc, 0x9a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0xe3, 0x80, 0x81, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0xe3, 0x80, 0x81, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x20, 0xe6, 0x88, 0x96,
+	0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a,
+	0x92, 0x41, 0x17, 0x32, 0x15, 0xe5, 0xba, 0x94, 0xe7, 0x94, 0xa8, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4,
+	0xa5, 0xe7, 0x9a, 0x84, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x42, 0x0a, 0x05, 0x64, 0x72, 0x69, 0x66, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x2c, 0x92, 0x41, 0x29, 0x32, 0x27, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8, 0x80,
+	0xe6, 0xac, 0xa1, 0xe5, 0x8f, 0x91, 0xe7, 0x8e, 0xb0, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0x8e, 0xe6,
+	0xb8, 0x85, 0xe5, 0x8d, 0x95, 0xe7, 0x9a, 0x84, 0xe5, 0x81, 0x8f, 0xe5, 0xb7, 0xae, 0x52, 0x05,
+	0x64, 0x72, 0x69, 0x66, 0x74, 0x12, 0x45, 0x0a, 0x0a, 0x64, 0x72, 0x69, 0x66, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21,
+	0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe5, 0x8f, 0x91, 0xe7,
+	0x8e, 0xb0, 0xe5, 0x81, 0x8f, 0xe5, 0xb7, 0xae, 0xe7, 0x9a, 0x84, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
+	0xb4, 0x52, 0x09, 0x64, 0x72, 0x69, 0x66, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x36, 0x0a, 0x15,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0xc5, 0x01, 0x0a, 0x16, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3d,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25,
+	0x92, 0x41, 0x22, 0x32, 0x20, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6, 0xe6, 0x80,
+	0x81, 0xef, 0xbc, 0x9a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4d, 0x0a,
+	0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x31, 0x92, 0x41, 0x2e, 0x32, 0x2c, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c, 0xe7, 0x9a, 0x84, 0xe5,
+	0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x95, 0xb0, 0xef, 0xbc, 0x8c, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0xe5, 0xb7, 0xb2, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba,
+	0x20, 0x30, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x37, 0x0a, 0x16,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49,
+	0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x25, 0x92, 0x41, 0x22, 0x32, 0x20, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x8a, 0xb6,
+	0xe6, 0x80, 0x81, 0xef, 0xbc, 0x9a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20, 0xe6, 0x88, 0x96,
+	0x20, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x4d, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x31, 0x92, 0x41, 0x2e, 0x32, 0x2c, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d, 0xe7, 0x9a,
+	0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbd, 0x93, 0xe6, 0x95, 0xb0, 0xef, 0xbc, 0x8c, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0xe6, 0x9c, 0xaa, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c, 0xe6, 0x97, 0xb6, 0xe4,
+	0xb8, 0xba, 0x20, 0x30, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x32, 0x81,
+	0x3b, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0xf2, 0x01, 0x0a,
+	0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49,
+	0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x92, 0x41, 0x4f, 0x2a,
+	0x16, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12,
+	0x1d, 0x61, 0x64, 0x64, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x62, 0x79, 0x20, 0x69, 0x64, 0x73, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x3a, 0x01,
+	0x2a, 0x12, 0xff, 0x01, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x92, 0x41, 0x55, 0x12, 0x20, 0x61,
+	0x64, 0x64, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x62, 0x79, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2a,
+	0x19, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
+	0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x3a, 0x01, 0x2a, 0x12, 0xff, 0x01, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x12, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x92, 0x41, 0x55, 0x2a,
+	0x19, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
+	0x4f, 0x4b, 0x12, 0x20, 0x61, 0x64, 0x64, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x62, 0x79, 0x20, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x8d, 0x02, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x89, 0x01, 0x92, 0x41, 0x66, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x32, 0x61, 0x64,
+	0x64, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x20, 0x62, 0x79, 0x20, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x20, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2a, 0x18, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x42, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15,
+	0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x85, 0x02, 0x0a, 0x18, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49,
+	0x44, 0x73, 0x12, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x81, 0x01, 0x92, 0x41, 0x54, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x20, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x62, 0x79, 0x20, 0x69, 0x64, 0x73, 0x2a, 0x18,
+	0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x92, 0x02,
+	0x0a, 0x1b, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x34, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x92, 0x41, 0x5a,
+	0x12, 0x23, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x62, 0x79, 0x20, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2a, 0x1b, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22,
+	0x22, 0x1d, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a,
+	0x01, 0x2a, 0x12, 0x92, 0x02, 0x0a, 0x1b, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x12, 0x34, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42,
+	0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x85, 0x01, 0x92, 0x41, 0x5a, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
+	0x4f, 0x4b, 0x12, 0x23, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x62, 0x79,
+	0x20, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2a, 0x1b, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0xf1, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x77, 0x92, 0x41, 0x4c, 0x2a, 0x15, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x0a, 0x09,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x1b, 0x67, 0x65, 0x74, 0x20, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x6c,
+	0x69, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0xbb, 0x01, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
+	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x92, 0x41, 0x3b, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0xc0, 0x01, 0x0a, 0x0f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x28, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x58, 0x92, 0x41, 0x3b, 0x12, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
+	0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x32, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xbd, 0x01, 0x0a,
+	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x92, 0x41, 0x3b, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x2a, 0x0f, 0x2f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xae, 0x01, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x25, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x92, 0x41,
+	0x35, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x0d, 0x67, 0x65, 0x74, 0x20, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2a, 0x0c, 0x67, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xba, 0x01,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
+	0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x58, 0x92, 0x41, 0x3b, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
+	0x4f, 0x4b, 0x12, 0x12, 0x67, 0x65, 0x74, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x2a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0xda, 0x01, 0x0a, 0x10, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x12,
+	0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x92, 0x41, 0x52, 0x12, 0x27, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x20, 0x74,
+	0x6f, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x14, 0x1a, 0x0f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xe8, 0x01, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x12, 0x2b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x92, 0x41, 0x56, 0x12, 0x28,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x20, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x20, 0x69, 0x73, 0x20, 0x75, 0x73, 0x65, 0x72,
+	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x0a, 0x09, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04,
+	0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x3a,
+	0x01, 0x2a, 0x12, 0xd2, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x64, 0x92, 0x41, 0x40, 0x2a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f,
+	0x4b, 0x12, 0x13, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x62, 0x79, 0x20,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xfd, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7a, 0x92, 0x41, 0x56,
+	0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x22, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x2a, 0x18, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x0a, 0x09, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x1a, 0x16, 0x2f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xfb, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75,
+	0x92, 0x41, 0x54, 0x12, 0x1f, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x2a, 0x19, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xf9, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x92, 0x41, 0x4c, 0x12, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a,
+	0x22, 0x1f, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0xe0, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x92, 0x41, 0x46, 0x2a, 0x14,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x16, 0x47, 0x65,
+	0x74, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x92, 0x41, 0x36, 0x4a, 0x0b, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x12, 0xe6, 0x9f, 0xa5, 0xe8,
+	0xaf, 0xa2, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe9, 0x85, 0x8d, 0xe9, 0xa2, 0x9d, 0x2a, 0x08,
+	0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0xce, 0x01, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66, 0x92, 0x41, 0x43, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x18, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0xe5, 0xae, 0xa1, 0xe8, 0xae, 0xa1, 0xe6, 0x97, 0xa5, 0xe5, 0xbf, 0x97,
+	0x2a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x22, 0x15, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xe8, 0x01, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e, 0x92, 0x41, 0x49, 0x2a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x73, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b,
+	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x18, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0xe5, 0xb7, 0xb2, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0x9a, 0x84, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x2f,
+	0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xd4, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x29, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x69, 0x92, 0x41, 0x44, 0x12, 0x18, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d,
+	0xe5, 0xb7, 0xb2, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9,
+	0x98, 0x85, 0x2a, 0x10, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1c, 0x22, 0x17, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xf8,
+	0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x78, 0x92, 0x41, 0x51, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f,
+	0x4b, 0x12, 0x1e, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7,
+	0x9a, 0x84, 0xe5, 0x8a, 0xa8, 0xe6, 0x80, 0x81, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0xe5, 0x99,
+	0xa8, 0x2a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xf1, 0x01, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x74, 0x92, 0x41, 0x50, 0x12, 0x1e, 0xe6, 0x9f,
+	0xa5, 0xe8, 0xaf, 0xa2, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0x8a, 0xa8,
+	0xe6, 0x80, 0x81, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0x2a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x84, 0x02,
+	0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83,
+	0x01, 0x92, 0x41, 0x51, 0x12, 0x1e, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe8, 0xae, 0xa2, 0xe9,
+	0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0x8a, 0xa8, 0xe6, 0x80, 0x81, 0xe9, 0x80, 0x89, 0xe6, 0x8b,
+	0xa9, 0xe5, 0x99, 0xa8, 0x2a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x0a, 0x09, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
+	0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x2a, 0x27, 0x2f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x80, 0x02, 0x0a, 0x16, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x82, 0x01, 0x92, 0x41, 0x56, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b,
+	0x12, 0x24, 0xe7, 0xab, 0x8b, 0xe5, 0x8d, 0xb3, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe8, 0xae,
+	0xa2, 0xe9, 0x98, 0x85, 0xe7, 0x9a, 0x84, 0xe5, 0x8a, 0xa8, 0xe6, 0x80, 0x81, 0xe9, 0x80, 0x89,
+	0xe6, 0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0x2a, 0x16, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x12, 0xc2, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x57, 0x92, 0x41, 0x38, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
+	0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x0c, 0xe5, 0xaf, 0xbc, 0xe5, 0x87, 0xba, 0xe8, 0xae, 0xa2,
+	0xe9, 0x98, 0x85, 0x2a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x73, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xc2, 0x01, 0x0a,
+	0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x73, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x92, 0x41, 0x38, 0x2a, 0x10, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x0c, 0xe5, 0xaf, 0xbc, 0xe5, 0x85, 0xa5, 0xe8,
+	0xae, 0xa2, 0xe9, 0x98, 0x85, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01,
+	0x2a, 0x12, 0xcb, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x92, 0x41, 0x4a, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f,
+	0x4b, 0x12, 0x21, 0xe5, 0x88, 0x97, 0xe5, 0x87, 0xba, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85, 0xe6,
+	0xb8, 0x85, 0xe5, 0x8d, 0x95, 0xe7, 0x9a, 0x84, 0xe5, 0xba, 0x94, 0xe7, 0x94, 0xa8, 0xe7, 0x8a,
+	0xb6, 0xe6, 0x80, 0x81, 0x2a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
+	0xbe, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x92, 0x41, 0x36, 0x2a, 0x0e, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
+	0x4f, 0x4b, 0x12, 0x0c, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x3a, 0x01, 0x2a,
+	0x12, 0xcf, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x92, 0x41, 0x43, 0x2a, 0x0f,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x18, 0xe6, 0x81, 0xa2, 0xe5, 0xa4, 0x8d, 0xe5,
+	0xb7, 0xb2, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c, 0xe7, 0x9a, 0x84, 0xe8, 0xae, 0xa2, 0xe9, 0x98,
+	0x85, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x3a,
+	0x01, 0x2a, 0x42, 0x49, 0x0a, 0x10, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2d, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_subscribe_v1_subscribe_proto_rawDescOnce sync.Once
+	file_api_subscribe_v1_subscribe_proto_rawDescData = file_api_subscribe_v1_subscribe_proto_rawDesc
+)
+
+func file_api_subscribe_v1_subscribe_proto_rawDescGZIP() []byte {
+	file_api_subscribe_v1_subscribe_proto_rawDescOnce.Do(func() {
+		file_api_subscribe_v1_subscribe_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_subscribe_v1_subscribe_proto_rawDescData)
+	})
+	return file_api_subscribe_v1_subscribe_proto_rawDescData
+}
+
+var file_api_subscribe_v1_subscribe_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
+var file_api_subscribe_v1_subscribe_proto_goTypes = []interface{}{
+	(*SubscribeEntitiesByIDsRequest)(nil),       // 0: api.subscribe.v1.SubscribeEntitiesByIDsRequest
+	(*SubscribeEntitiesByIDsResponse)(nil),      // 1: api.subscribe.v1.SubscribeEntitiesByIDsResponse
+	(*SubscribeEntitiesByGroupsRequest)(nil),    // 2: api.subscribe.v1.SubscribeEntitiesByGroupsRequest
+	(*SubscribeEntitiesByGroupsResponse)(nil),   // 3: api.subscribe.v1.SubscribeEntitiesByGroupsResponse
+	(*SubscribeEntitiesByModelsRequest)(nil),    // 4: api.subscribe.v1.SubscribeEntitiesByModelsRequest
+	(*SubscribeEntitiesByModelsResponse)(nil),   // 5: api.subscribe.v1.SubscribeEntitiesByModelsResponse
+	(*QuotaProjection)(nil),                     // 6: api.subscribe.v1.QuotaProjection
+	(*SubscribeEntitiesByQueryRequest)(nil),     // 7: api.subscribe.v1.SubscribeEntitiesByQueryRequest
+	(*QueriedDevice)(nil),                       // 8: api.subscribe.v1.QueriedDevice
+	(*SubscribeEntitiesByQueryResponse)(nil),    // 9: api.subscribe.v1.SubscribeEntitiesByQueryResponse
+	(*UnsubscribeEntitiesByIDsRequest)(nil),     // 10: api.subscribe.v1.UnsubscribeEntitiesByIDsRequest
+	(*UnsubscribeEntitiesByIDsResponse)(nil),    // 11: api.subscribe.v1.UnsubscribeEntitiesByIDsResponse
+	(*UnsubscribeEntitiesByGroupsRequest)(nil),  // 12: api.subscribe.v1.UnsubscribeEntitiesByGroupsRequest
+	(*UnsubscribeEntitiesByGroupsResponse)(nil), // 13: api.subscribe.v1.UnsubscribeEntitiesByGroupsResponse
+	(*UnsubscribeEntitiesByModelsRequest)(nil),  // 14: api.subscribe.v1.UnsubscribeEntitiesByModelsRequest
+	(*UnsubscribeEntitiesByModelsResponse)(nil), // 15: api.subscribe.v1.UnsubscribeEntitiesByModelsResponse
+	(*ListSubscribeEntitiesRequest)(nil),        // 16: api.subscribe.v1.ListSubscribeEntitiesRequest
+	(*ListSubscribeEntitiesResponse)(nil),       // 17: api.subscribe.v1.ListSubscribeEntitiesResponse
+	(*SubscribeObject)(nil),                     // 18: api.subscribe.v1.SubscribeObject
+	(*CreateSubscribeRequest)(nil),              // 19: api.subscribe.v1.CreateSubscribeRequest
+	(*CreateSubscribeResponse)(nil),             // 20: api.subscribe.v1.CreateSubscribeResponse
+	(*UpdateSubscribeRequest)(nil),              // 21: api.subscribe.v1.UpdateSubscribeRequest
+	(*UpdateSubscribeResponse)(nil),             // 22: api.subscribe.v1.UpdateSubscribeResponse
+	(*DeleteSubscribeRequest)(nil),              // 23: api.subscribe.v1.DeleteSubscribeRequest
+	(*DeleteSubscribeResponse)(nil),             // 24: api.subscribe.v1.DeleteSubscribeResponse
+	(*GetSubscribeRequest)(nil),                 // 25: api.subscribe.v1.GetSubscribeRequest
+	(*GetSubscribeResponse)(nil),                // 26: api.subscribe.v1.GetSubscribeResponse
+	(*ListSubscribeRequest)(nil),                // 27: api.subscribe.v1.ListSubscribeRequest
+	(*ListSubscribeResponse)(nil),               // 28: api.subscribe.v1.ListSubscribeResponse
+	(*ChangeSubscribedRequest)(nil),             // 29: api.subscribe.v1.ChangeSubscribedRequest
+	(*ChangeSubscribedResponse)(nil),            // 30: api.subscribe.v1.ChangeSubscribedResponse
+	(*Entity)(nil),                              // 31: api.subscribe.v1.Entity
+	(*ValidateSubscribedRequest)(nil),           // 32: api.subscribe.v1.ValidateSubscribedRequest
+	(*ValidateSubscribedResponse)(nil),          // 33: api.subscribe.v1.ValidateSubscribedResponse
+	(*SubscribeByDeviceRequest)(nil),            // 34: api.subscribe.v1.SubscribeByDeviceRequest
+	(*SubscribeByDeviceResponse)(nil),           // 35: api.subscribe.v1.SubscribeByDeviceResponse
+	(*ReplaceSubscribeByDeviceRequest)(nil),     // 36: api.subscribe.v1.ReplaceSubscribeByDeviceRequest
+	(*ReplaceSubscribeByDeviceResponse)(nil),    // 37: api.subscribe.v1.ReplaceSubscribeByDeviceResponse
+	(*ListSubscriptionsByEntityRequest)(nil),    // 38: api.subscribe.v1.ListSubscriptionsByEntityRequest
+	(*ListSubscriptionsByEntityResponse)(nil),   // 39: api.subscribe.v1.ListSubscriptionsByEntityResponse
+	(*ListSubscribeOperationsRequest)(nil),      // 40: api.subscribe.v1.ListSubscribeOperationsRequest
+	(*ListSubscribeOperationsResponse)(nil),     // 41: api.subscribe.v1.ListSubscribeOperationsResponse
+	(*SubscribeOperation)(nil),                  // 42: api.subscribe.v1.SubscribeOperation
+	(*BulkSubscribeSummary)(nil),                // 43: api.subscribe.v1.BulkSubscribeSummary
+	(*BulkUnsubscribeSummary)(nil),              // 44: api.subscribe.v1.BulkUnsubscribeSummary
+	(*EntityResult)(nil),                        // 45: api.subscribe.v1.EntityResult
+	(*GetSubscribeProgressRequest)(nil),         // 46: api.subscribe.v1.GetSubscribeProgressRequest
+	(*GetSubscribeProgressResponse)(nil),        // 47: api.subscribe.v1.GetSubscribeProgressResponse
+	(*GetQuotaRequest)(nil),                     // 48: api.subscribe.v1.GetQuotaRequest
+	(*GetQuotaResponse)(nil),                    // 49: api.subscribe.v1.GetQuotaResponse
+	(*ListAuditEventsRequest)(nil),              // 50: api.subscribe.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),             // 51: api.subscribe.v1.ListAuditEventsResponse
+	(*AuditEvent)(nil),                          // 52: api.subscribe.v1.AuditEvent
+	(*ListDeletedSubscribesRequest)(nil),        // 53: api.subscribe.v1.ListDeletedSubscribesRequest
+	(*ListDeletedSubscribesResponse)(nil),       // 54: api.subscribe.v1.ListDeletedSubscribesResponse
+	(*DeletedSubscribe)(nil),                    // 55: api.subscribe.v1.DeletedSubscribe
+	(*RestoreSubscribeRequest)(nil),             // 56: api.subscribe.v1.RestoreSubscribeRequest
+	(*RestoreSubscribeResponse)(nil),            // 57: api.subscribe.v1.RestoreSubscribeResponse
+	(*SelectorCondition)(nil),                   // 58: api.subscribe.v1.SelectorCondition
+	(*SubscribeSelector)(nil),                   // 59: api.subscribe.v1.SubscribeSelector
+	(*SelectorSyncResult)(nil),                  // 60: api.subscribe.v1.SelectorSyncResult
+	(*CreateSubscribeSelectorRequest)(nil),      // 61: api.subscribe.v1.CreateSubscribeSelectorRequest
+	(*CreateSubscribeSelectorResponse)(nil),     // 62: api.subscribe.v1.CreateSubscribeSelectorResponse
+	(*ListSubscribeSelectorsRequest)(nil),       // 63: api.subscribe.v1.ListSubscribeSelectorsRequest
+	(*ListSubscribeSelectorsResponse)(nil),      // 64: api.subscribe.v1.ListSubscribeSelectorsResponse
+	(*DeleteSubscribeSelectorRequest)(nil),      // 65: api.subscribe.v1.DeleteSubscribeSelectorRequest
+	(*DeleteSubscribeSelectorResponse)(nil),     // 66: api.subscribe.v1.DeleteSubscribeSelectorResponse
+	(*SyncSubscribeSelectorsRequest)(nil),       // 67: api.subscribe.v1.SyncSubscribeSelectorsRequest
+	(*SyncSubscribeSelectorsResponse)(nil),      // 68: api.subscribe.v1.SyncSubscribeSelectorsResponse
+	(*ExportSubscribesRequest)(nil),             // 69: api.subscribe.v1.ExportSubscribesRequest
+	(*ExportSubscribesResponse)(nil),            // 70: api.subscribe.v1.ExportSubscribesResponse
+	(*ImportSubscribesRequest)(nil),             // 71: api.subscribe.v1.ImportSubscribesRequest
+	(*ImportSubscribeResult)(nil),               // 72: api.subscribe.v1.ImportSubscribeResult
+	(*ImportSubscribesResponse)(nil),            // 73: api.subscribe.v1.ImportSubscribesResponse
+	(*ListManifestsRequest)(nil),                // 74: api.subscribe.v1.ListManifestsRequest
+	(*ListManifestsResponse)(nil),               // 75: api.subscribe.v1.ListManifestsResponse
+	(*ManifestStatus)(nil),                      // 76: api.subscribe.v1.ManifestStatus
+	(*ManifestSubscribeStatus)(nil),             // 77: api.subscribe.v1.ManifestSubscribeStatus
+	(*PauseSubscribeRequest)(nil),               // 78: api.subscribe.v1.PauseSubscribeRequest
+	(*PauseSubscribeResponse)(nil),              // 79: api.subscribe.v1.PauseSubscribeResponse
+	(*ResumeSubscribeRequest)(nil),              // 80: api.subscribe.v1.ResumeSubscribeRequest
+	(*ResumeSubscribeResponse)(nil),             // 81: api.subscribe.v1.ResumeSubscribeResponse
+}
+var file_api_subscribe_v1_subscribe_proto_depIdxs = []int32{
+	43, // 0: api.subscribe.v1.SubscribeEntitiesByIDsResponse.summary:type_name -> api.subscribe.v1.BulkSubscribeSummary
+	45, // 1: api.subscribe.v1.SubscribeEntitiesByIDsResponse.results:type_name -> api.subscribe.v1.EntityResult
+	43, // 2: api.subscribe.v1.SubscribeEntitiesByGroupsResponse.summary:type_name -> api.subscribe.v1.BulkSubscribeSummary
+	45, // 3: api.subscribe.v1.SubscribeEntitiesByGroupsResponse.results:type_name -> api.subscribe.v1.EntityResult
+	6,  // 4: api.subscribe.v1.SubscribeEntitiesByGroupsResponse.quota:type_name -> api.subscribe.v1.QuotaProjection
+	43, // 5: api.subscribe.v1.SubscribeEntitiesByModelsResponse.summary:type_name -> api.subscribe.v1.BulkSubscribeSummary
+	45, // 6: api.subscribe.v1.SubscribeEntitiesByModelsResponse.results:type_name -> api.subscribe.v1.EntityResult
+	6,  // 7: api.subscribe.v1.SubscribeEntitiesByModelsResponse.quota:type_name -> api.subscribe.v1.QuotaProjection
+	58, // 8: api.subscribe.v1.SubscribeEntitiesByQueryRequest.conditions:type_name -> api.subscribe.v1.SelectorCondition
+	43, // 9: api.subscribe.v1.SubscribeEntitiesByQueryResponse.summary:type_name -> api.subscribe.v1.BulkSubscribeSummary
+	45, // 10: api.subscribe.v1.SubscribeEntitiesByQueryResponse.results:type_name -> api.subscribe.v1.EntityResult
+	8,  // 11: api.subscribe.v1.SubscribeEntitiesByQueryResponse.devices:type_name -> api.subscribe.v1.QueriedDevice
+	6,  // 12: api.subscribe.v1.SubscribeEntitiesByQueryResponse.quota:type_name -> api.subscribe.v1.QuotaProjection
+	44, // 13: api.subscribe.v1.UnsubscribeEntitiesByIDsResponse.summary:type_name -> api.subscribe.v1.BulkUnsubscribeSummary
+	45, // 14: api.subscribe.v1.UnsubscribeEntitiesByIDsResponse.results:type_name -> api.subscribe.v1.EntityResult
+	44, // 15: api.subscribe.v1.UnsubscribeEntitiesByGroupsResponse.summary:type_name -> api.subscribe.v1.BulkUnsubscribeSummary
+	45, // 16: api.subscribe.v1.UnsubscribeEntitiesByGroupsResponse.results:type_name -> api.subscribe.v1.EntityResult
+	44, // 17: api.subscribe.v1.UnsubscribeEntitiesByModelsResponse.summary:type_name -> api.subscribe.v1.BulkUnsubscribeSummary
+	45, // 18: api.subscribe.v1.UnsubscribeEntitiesByModelsResponse.results:type_name -> api.subscribe.v1.EntityResult
+	31, // 19: api.subscribe.v1.ListSubscribeEntitiesResponse.data:type_name -> api.subscribe.v1.Entity
+	18, // 20: api.subscribe.v1.ListSubscribeResponse.data:type_name -> api.subscribe.v1.SubscribeObject
+	45, // 21: api.subscribe.v1.ChangeSubscribedResponse.results:type_name -> api.subscribe.v1.EntityResult
+	6,  // 22: api.subscribe.v1.ChangeSubscribedResponse.quota:type_name -> api.subscribe.v1.QuotaProjection
+	45, // 23: api.subscribe.v1.SubscribeByDeviceResponse.results:type_name -> api.subscribe.v1.EntityResult
+	43, // 24: api.subscribe.v1.SubscribeByDeviceResponse.summary:type_name -> api.subscribe.v1.BulkSubscribeSummary
+	45, // 25: api.subscribe.v1.ReplaceSubscribeByDeviceResponse.results:type_name -> api.subscribe.v1.EntityResult
+	18, // 26: api.subscribe.v1.ListSubscriptionsByEntityResponse.data:type_name -> api.subscribe.v1.SubscribeObject
+	42, // 27: api.subscribe.v1.ListSubscribeOperationsResponse.data:type_name -> api.subscribe.v1.SubscribeOperation
+	52, // 28: api.subscribe.v1.ListAuditEventsResponse.data:type_name -> api.subscribe.v1.AuditEvent
+	55, // 29: api.subscribe.v1.ListDeletedSubscribesResponse.data:type_name -> api.subscribe.v1.DeletedSubscribe
+	58, // 30: api.subscribe.v1.SubscribeSelector.conditions:type_name -> api.subscribe.v1.SelectorCondition
+	58, // 31: api.subscribe.v1.CreateSubscribeSelectorRequest.conditions:type_name -> api.subscribe.v1.SelectorCondition
+	59, // 32: api.subscribe.v1.CreateSubscribeSelectorResponse.selector:type_name -> api.subscribe.v1.SubscribeSelector
+	60, // 33: api.subscribe.v1.CreateSubscribeSelectorResponse.sync:type_name -> api.subscribe.v1.SelectorSyncResult
+	59, // 34: api.subscribe.v1.ListSubscribeSelectorsResponse.data:type_name -> api.subscribe.v1.SubscribeSelector
+	60, // 35: api.subscribe.v1.DeleteSubscribeSelectorResponse.sync:type_name -> api.subscribe.v1.SelectorSyncResult
+	60, // 36: api.subscribe.v1.SyncSubscribeSelectorsResponse.results:type_name -> api.subscribe.v1.SelectorSyncResult
+	45, // 37: api.subscribe.v1.ImportSubscribeResult.skipped_entities:type_name -> api.subscribe.v1.EntityResult
+	60, // 38: api.subscribe.v1.ImportSubscribeResult.selectors:type_name -> api.subscribe.v1.SelectorSyncResult
+	72, // 39: api.subscribe.v1.ImportSubscribesResponse.results:type_name -> api.subscribe.v1.ImportSubscribeResult
+	76, // 40: api.subscribe.v1.ListManifestsResponse.manifests:type_name -> api.subscribe.v1.ManifestStatus
+	77, // 41: api.subscribe.v1.ManifestStatus.subscribes:type_name -> api.subscribe.v1.ManifestSubscribeStatus
+	0,  // 42: api.subscribe.v1.Subscribe.SubscribeEntitiesByIDs:input_type -> api.subscribe.v1.SubscribeEntitiesByIDsRequest
+	2,  // 43: api.subscribe.v1.Subscribe.SubscribeEntitiesByGroups:input_type -> api.subscribe.v1.SubscribeEntitiesByGroupsRequest
+	4,  // 44: api.subscribe.v1.Subscribe.SubscribeEntitiesByModels:input_type -> api.subscribe.v1.SubscribeEntitiesByModelsRequest
+	7,  // 45: api.subscribe.v1.Subscribe.SubscribeEntitiesByQuery:input_type -> api.subscribe.v1.SubscribeEntitiesByQueryRequest
+	10, // 46: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByIDs:input_type -> api.subscribe.v1.UnsubscribeEntitiesByIDsRequest
+	12, // 47: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByGroups:input_type -> api.subscribe.v1.UnsubscribeEntitiesByGroupsRequest
+	14, // 48: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByModels:input_type -> api.subscribe.v1.UnsubscribeEntitiesByModelsRequest
+	16, // 49: api.subscribe.v1.Subscribe.ListSubscribeEntities:input_type -> api.subscribe.v1.ListSubscribeEntitiesRequest
+	19, // 50: api.subscribe.v1.Subscribe.CreateSubscribe:input_type -> api.subscribe.v1.CreateSubscribeRequest
+	21, // 51: api.subscribe.v1.Subscribe.UpdateSubscribe:input_type -> api.subscribe.v1.UpdateSubscribeRequest
+	23, // 52: api.subscribe.v1.Subscribe.DeleteSubscribe:input_type -> api.subscribe.v1.DeleteSubscribeRequest
+	25, // 53: api.subscribe.v1.Subscribe.GetSubscribe:input_type -> api.subscribe.v1.GetSubscribeRequest
+	27, // 54: api.subscribe.v1.Subscribe.ListSubscribe:input_type -> api.subscribe.v1.ListSubscribeRequest
+	29, // 55: api.subscribe.v1.Subscribe.ChangeSubscribed:input_type -> api.subscribe.v1.ChangeSubscribedRequest
+	32, // 56: api.subscribe.v1.Subscribe.ValidateSubscribed:input_type -> api.subscribe.v1.ValidateSubscribedRequest
+	34, // 57: api.subscribe.v1.Subscribe.SubscribeByDevice:input_type -> api.subscribe.v1.SubscribeByDeviceRequest
+	36, // 58: api.subscribe.v1.Subscribe.ReplaceSubscribeByDevice:input_type -> api.subscribe.v1.ReplaceSubscribeByDeviceRequest
+	38, // 59: api.subscribe.v1.Subscribe.ListSubscriptionsByEntity:input_type -> api.subscribe.v1.ListSubscriptionsByEntityRequest
+	40, // 60: api.subscribe.v1.Subscribe.ListSubscribeOperations:input_type -> api.subscribe.v1.ListSubscribeOperationsRequest
+	46, // 61: api.subscribe.v1.Subscribe.GetSubscribeProgress:input_type -> api.subscribe.v1.GetSubscribeProgressRequest
+	48, // 62: api.subscribe.v1.Subscribe.GetQuota:input_type -> api.subscribe.v1.GetQuotaRequest
+	50, // 63: api.subscribe.v1.Subscribe.ListAuditEvents:input_type -> api.subscribe.v1.ListAuditEventsRequest
+	53, // 64: api.subscribe.v1.Subscribe.ListDeletedSubscribes:input_type -> api.subscribe.v1.ListDeletedSubscribesRequest
+	56, // 65: api.subscribe.v1.Subscribe.RestoreSubscribe:input_type -> api.subscribe.v1.RestoreSubscribeRequest
+	61, // 66: api.subscribe.v1.Subscribe.CreateSubscribeSelector:input_type -> api.subscribe.v1.CreateSubscribeSelectorRequest
+	63, // 67: api.subscribe.v1.Subscribe.ListSubscribeSelectors:input_type -> api.subscribe.v1.ListSubscribeSelectorsRequest
+	65, // 68: api.subscribe.v1.Subscribe.DeleteSubscribeSelector:input_type -> api.subscribe.v1.DeleteSubscribeSelectorRequest
+	67, // 69: api.subscribe.v1.Subscribe.SyncSubscribeSelectors:input_type -> api.subscribe.v1.SyncSubscribeSelectorsRequest
+	69, // 70: api.subscribe.v1.Subscribe.ExportSubscribes:input_type -> api.subscribe.v1.ExportSubscribesRequest
+	71, // 71: api.subscribe.v1.Subscribe.ImportSubscribes:input_type -> api.subscribe.v1.ImportSubscribesRequest
+	74, // 72: api.subscribe.v1.Subscribe.ListManifests:input_type -> api.subscribe.v1.ListManifestsRequest
+	78, // 73: api.subscribe.v1.Subscribe.PauseSubscribe:input_type -> api.subscribe.v1.PauseSubscribeRequest
+	80, // 74: api.subscribe.v1.Subscribe.ResumeSubscribe:input_type -> api.subscribe.v1.ResumeSubscribeRequest
+	1,  // 75: api.subscribe.v1.Subscribe.SubscribeEntitiesByIDs:output_type -> api.subscribe.v1.SubscribeEntitiesByIDsResponse
+	3,  // 76: api.subscribe.v1.Subscribe.SubscribeEntitiesByGroups:output_type -> api.subscribe.v1.SubscribeEntitiesByGroupsResponse
+	5,  // 77: api.subscribe.v1.Subscribe.SubscribeEntitiesByModels:output_type -> api.subscribe.v1.SubscribeEntitiesByModelsResponse
+	9,  // 78: api.subscribe.v1.Subscribe.SubscribeEntitiesByQuery:output_type -> api.subscribe.v1.SubscribeEntitiesByQueryResponse
+	11, // 79: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByIDs:output_type -> api.subscribe.v1.UnsubscribeEntitiesByIDsResponse
+	13, // 80: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByGroups:output_type -> api.subscribe.v1.UnsubscribeEntitiesByGroupsResponse
+	15, // 81: api.subscribe.v1.Subscribe.UnsubscribeEntitiesByModels:output_type -> api.subscribe.v1.UnsubscribeEntitiesByModelsResponse
+	17, // 82: api.subscribe.v1.Subscribe.ListSubscribeEntities:output_type -> api.subscribe.v1.ListSubscribeEntitiesResponse
+	20, // 83: api.subscribe.v1.Subscribe.CreateSubscribe:output_type -> api.subscribe.v1.CreateSubscribeResponse
+	22, // 84: api.subscribe.v1.Subscribe.UpdateSubscribe:output_type -> api.subscribe.v1.UpdateSubscribeResponse
+	24, // 85: api.subscribe.v1.Subscribe.DeleteSubscribe:output_type -> api.subscribe.v1.DeleteSubscribeResponse
+	26, // 86: api.subscribe.v1.Subscribe.GetSubscribe:output_type -> api.subscribe.v1.GetSubscribeResponse
+	28, // 87: api.subscribe.v1.Subscribe.ListSubscribe:output_type -> api.subscribe.v1.ListSubscribeResponse
+	30, // 88: api.subscribe.v1.Subscribe.ChangeSubscribed:output_type -> api.subscribe.v1.ChangeSubscribedResponse
+	33, // 89: api.subscribe.v1.Subscribe.ValidateSubscribed:output_type -> api.subscribe.v1.ValidateSubscribedResponse
+	35, // 90: api.subscribe.v1.Subscribe.SubscribeByDevice:output_type -> api.subscribe.v1.SubscribeByDeviceResponse
+	37, // 91: api.subscribe.v1.Subscribe.ReplaceSubscribeByDevice:output_type -> api.subscribe.v1.ReplaceSubscribeByDeviceResponse
+	39, // 92: api.subscribe.v1.Subscribe.ListSubscriptionsByEntity:output_type -> api.subscribe.v1.ListSubscriptionsByEntityResponse
+	41, // 93: api.subscribe.v1.Subscribe.ListSubscribeOperations:output_type -> api.subscribe.v1.ListSubscribeOperationsResponse
+	47, // 94: api.subscribe.v1.Subscribe.GetSubscribeProgress:output_type -> api.subscribe.v1.GetSubscribeProgressResponse
+	49, // 95: api.subscribe.v1.Subscribe.GetQuota:output_type -> api.subscribe.v1.GetQuotaResponse
+	51, // 96: api.subscribe.v1.Subscribe.ListAuditEvents:output_type -> api.subscribe.v1.ListAuditEventsResponse
+	54, // 97: api.subscribe.v1.Subscribe.ListDeletedSubscribes:output_type -> api.subscribe.v1.ListDeletedSubscribesResponse
+	57, // 98: api.subscribe.v1.Subscribe.RestoreSubscribe:output_type -> api.subscribe.v1.RestoreSubscribeResponse
+	62, // 99: api.subscribe.v1.Subscribe.CreateSubscribeSelector:output_type -> api.subscribe.v1.CreateSubscribeSelectorResponse
+	64, // 100: api.subscribe.v1.Subscribe.ListSubscribeSelectors:output_type -> api.subscribe.v1.ListSubscribeSelectorsResponse
+	66, // 101: api.subscribe.v1.Subscribe.DeleteSubscribeSelector:output_type -> api.subscribe.v1.DeleteSubscribeSelectorResponse
+	68, // 102: api.subscribe.v1.Subscribe.SyncSubscribeSelectors:output_type -> api.subscribe.v1.SyncSubscribeSelectorsResponse
+	70, // 103: api.subscribe.v1.Subscribe.ExportSubscribes:output_type -> api.subscribe.v1.ExportSubscribesResponse
+	73, // 104: api.subscribe.v1.Subscribe.ImportSubscribes:output_type -> api.subscribe.v1.ImportSubscribesResponse
+	75, // 105: api.subscribe.v1.Subscribe.ListManifests:output_type -> api.subscribe.v1.ListManifestsResponse
+	79, // 106: api.subscribe.v1.Subscribe.PauseSubscribe:output_type -> api.subscribe.v1.PauseSubscribeResponse
+	81, // 107: api.subscribe.v1.Subscribe.ResumeSubscribe:output_type -> api.subscribe.v1.ResumeSubscribeResponse
+	75, // [75:108] is the sub-list for method output_type
+	42, // [42:75] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_api_subscribe_v1_subscribe_proto_init() }
+func file_api_subscribe_v1_subscribe_proto_init() {
+	if File_api_subscribe_v1_subscribe_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeEntitiesByIDsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeEntitiesByIDsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeEntitiesByGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeEntitiesByGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeEntitiesByModelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeEntitiesByModelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaProjection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeEntitiesByQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueriedDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeEntitiesByQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeEntitiesByIDsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeEntitiesByIDsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeEntitiesByGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeEntitiesByGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeEntitiesByModelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeEntitiesByModelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscribeEntitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscribeEntitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeObject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubscribeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSubscribeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSubscribeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscribeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscribeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeSubscribedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeSubscribedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateSubscribedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateSubscribedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeByDeviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeByDeviceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaceSubscribeByDeviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaceSubscribeByDeviceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscriptionsByEntityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscriptionsByEntityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscribeOperationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscribeOperationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkSubscribeSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkUnsubscribeSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscribeProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscribeProgressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuotaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2378,8 +8567,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeEntitiesByIDsResponse); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2390,8 +8579,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeEntitiesByGroupsRequest); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedSubscribesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2402,8 +8591,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeEntitiesByGroupsResponse); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedSubscribesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2414,8 +8603,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeEntitiesByModelsRequest); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletedSubscribe); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2426,8 +8615,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeEntitiesByModelsResponse); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreSubscribeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2438,8 +8627,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribeEntitiesByIDsRequest); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreSubscribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2450,8 +8639,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribeEntitiesByIDsResponse); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectorCondition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2462,8 +8651,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSubscribeEntitiesRequest); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSelector); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2474,8 +8663,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSubscribeEntitiesResponse); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectorSyncResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2486,8 +8675,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeObject); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubscribeSelectorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2498,8 +8687,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSubscribeRequest); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubscribeSelectorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2510,8 +8699,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSubscribeResponse); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscribeSelectorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2522,8 +8711,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSubscribeRequest); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscribeSelectorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2534,8 +8723,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSubscribeResponse); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSubscribeSelectorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2546,8 +8735,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSubscribeRequest); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSubscribeSelectorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2558,8 +8747,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSubscribeResponse); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncSubscribeSelectorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2570,8 +8759,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubscribeRequest); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncSubscribeSelectorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2582,8 +8771,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSubscribeResponse); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSubscribesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2594,8 +8783,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSubscribeRequest); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSubscribesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2606,8 +8795,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSubscribeResponse); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSubscribesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2618,8 +8807,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeSubscribedRequest); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSubscribeResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2630,8 +8819,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeSubscribedResponse); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSubscribesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2642,8 +8831,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Entity); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListManifestsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2654,8 +8843,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateSubscribedRequest); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListManifestsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2666,8 +8855,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateSubscribedResponse); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManifestStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2678,8 +8867,8 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeByDeviceRequest); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManifestSubscribeStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2690,8 +8879,44 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 				return nil
 			}
 		}
-		file_api_subscribe_v1_subscribe_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeByDeviceResponse); i {
+		file_api_subscribe_v1_subscribe_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseSubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseSubscribeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeSubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_subscribe_v1_subscribe_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeSubscribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2709,7 +8934,7 @@ func file_api_subscribe_v1_subscribe_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_subscribe_v1_subscribe_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
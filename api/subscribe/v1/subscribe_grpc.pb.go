@@ -21,7 +21,10 @@ type SubscribeClient interface {
 	SubscribeEntitiesByIDs(ctx context.Context, in *SubscribeEntitiesByIDsRequest, opts ...grpc.CallOption) (*SubscribeEntitiesByIDsResponse, error)
 	SubscribeEntitiesByGroups(ctx context.Context, in *SubscribeEntitiesByGroupsRequest, opts ...grpc.CallOption) (*SubscribeEntitiesByGroupsResponse, error)
 	SubscribeEntitiesByModels(ctx context.Context, in *SubscribeEntitiesByModelsRequest, opts ...grpc.CallOption) (*SubscribeEntitiesByModelsResponse, error)
+	SubscribeEntitiesByQuery(ctx context.Context, in *SubscribeEntitiesByQueryRequest, opts ...grpc.CallOption) (*SubscribeEntitiesByQueryResponse, error)
 	UnsubscribeEntitiesByIDs(ctx context.Context, in *UnsubscribeEntitiesByIDsRequest, opts ...grpc.CallOption) (*UnsubscribeEntitiesByIDsResponse, error)
+	UnsubscribeEntitiesByGroups(ctx context.Context, in *UnsubscribeEntitiesByGroupsRequest, opts ...grpc.CallOption) (*UnsubscribeEntitiesByGroupsResponse, error)
+	UnsubscribeEntitiesByModels(ctx context.Context, in *UnsubscribeEntitiesByModelsRequest, opts ...grpc.CallOption) (*UnsubscribeEntitiesByModelsResponse, error)
 	ListSubscribeEntities(ctx context.Context, in *ListSubscribeEntitiesRequest, opts ...grpc.CallOption) (*ListSubscribeEntitiesResponse, error)
 	CreateSubscribe(ctx context.Context, in *CreateSubscribeRequest, opts ...grpc.CallOption) (*CreateSubscribeResponse, error)
 	UpdateSubscribe(ctx context.Context, in *UpdateSubscribeRequest, opts ...grpc.CallOption) (*UpdateSubscribeResponse, error)
@@ -31,6 +34,23 @@ type SubscribeClient interface {
 	ChangeSubscribed(ctx context.Context, in *ChangeSubscribedRequest, opts ...grpc.CallOption) (*ChangeSubscribedResponse, error)
 	ValidateSubscribed(ctx context.Context, in *ValidateSubscribedRequest, opts ...grpc.CallOption) (*ValidateSubscribedResponse, error)
 	SubscribeByDevice(ctx context.Context, in *SubscribeByDeviceRequest, opts ...grpc.CallOption) (*SubscribeByDeviceResponse, error)
+	ReplaceSubscribeByDevice(ctx context.Context, in *ReplaceSubscribeByDeviceRequest, opts ...grpc.CallOption) (*ReplaceSubscribeByDeviceResponse, error)
+	ListSubscriptionsByEntity(ctx context.Context, in *ListSubscriptionsByEntityRequest, opts ...grpc.CallOption) (*ListSubscriptionsByEntityResponse, error)
+	ListSubscribeOperations(ctx context.Context, in *ListSubscribeOperationsRequest, opts ...grpc.CallOption) (*ListSubscribeOperationsResponse, error)
+	GetSubscribeProgress(ctx context.Context, in *GetSubscribeProgressRequest, opts ...grpc.CallOption) (*GetSubscribeProgressResponse, error)
+	GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*GetQuotaResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	ListDeletedSubscribes(ctx context.Context, in *ListDeletedSubscribesRequest, opts ...grpc.CallOption) (*ListDeletedSubscribesResponse, error)
+	RestoreSubscribe(ctx context.Context, in *RestoreSubscribeRequest, opts ...grpc.CallOption) (*RestoreSubscribeResponse, error)
+	CreateSubscribeSelector(ctx context.Context, in *CreateSubscribeSelectorRequest, opts ...grpc.CallOption) (*CreateSubscribeSelectorResponse, error)
+	ListSubscribeSelectors(ctx context.Context, in *ListSubscribeSelectorsRequest, opts ...grpc.CallOption) (*ListSubscribeSelectorsResponse, error)
+	DeleteSubscribeSelector(ctx context.Context, in *DeleteSubscribeSelectorRequest, opts ...grpc.CallOption) (*DeleteSubscribeSelectorResponse, error)
+	SyncSubscribeSelectors(ctx context.Context, in *SyncSubscribeSelectorsRequest, opts ...grpc.CallOption) (*SyncSubscribeSelectorsResponse, error)
+	ExportSubscribes(ctx context.Context, in *ExportSubscribesRequest, opts ...grpc.CallOption) (*ExportSubscribesResponse, error)
+	ImportSubscribes(ctx context.Context, in *ImportSubscribesRequest, opts ...grpc.CallOption) (*ImportSubscribesResponse, error)
+	ListManifests(ctx context.Context, in *ListManifestsRequest, opts ...grpc.CallOption) (*ListManifestsResponse, error)
+	PauseSubscribe(ctx context.Context, in *PauseSubscribeRequest, opts ...grpc.CallOption) (*PauseSubscribeResponse, error)
+	ResumeSubscribe(ctx context.Context, in *ResumeSubscribeRequest, opts ...grpc.CallOption) (*ResumeSubscribeResponse, error)
 }
 
 type subscribeClient struct {
@@ -68,6 +88,15 @@ func (c *subscribeClient) SubscribeEntitiesByModels(ctx context.Context, in *Sub
 	return out, nil
 }
 
+func (c *subscribeClient) SubscribeEntitiesByQuery(ctx context.Context, in *SubscribeEntitiesByQueryRequest, opts ...grpc.CallOption) (*SubscribeEntitiesByQueryResponse, error) {
+	out := new(SubscribeEntitiesByQueryResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/SubscribeEntitiesByQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *subscribeClient) UnsubscribeEntitiesByIDs(ctx context.Context, in *UnsubscribeEntitiesByIDsRequest, opts ...grpc.CallOption) (*UnsubscribeEntitiesByIDsResponse, error) {
 	out := new(UnsubscribeEntitiesByIDsResponse)
 	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/UnsubscribeEntitiesByIDs", in, out, opts...)
@@ -77,6 +106,24 @@ func (c *subscribeClient) UnsubscribeEntitiesByIDs(ctx context.Context, in *Unsu
 	return out, nil
 }
 
+func (c *subscribeClient) UnsubscribeEntitiesByGroups(ctx context.Context, in *UnsubscribeEntitiesByGroupsRequest, opts ...grpc.CallOption) (*UnsubscribeEntitiesByGroupsResponse, error) {
+	out := new(UnsubscribeEntitiesByGroupsResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/UnsubscribeEntitiesByGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) UnsubscribeEntitiesByModels(ctx context.Context, in *UnsubscribeEntitiesByModelsRequest, opts ...grpc.CallOption) (*UnsubscribeEntitiesByModelsResponse, error) {
+	out := new(UnsubscribeEntitiesByModelsResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/UnsubscribeEntitiesByModels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *subscribeClient) ListSubscribeEntities(ctx context.Context, in *ListSubscribeEntitiesRequest, opts ...grpc.CallOption) (*ListSubscribeEntitiesResponse, error) {
 	out := new(ListSubscribeEntitiesResponse)
 	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/ListSubscribeEntities", in, out, opts...)
@@ -158,6 +205,159 @@ func (c *subscribeClient) SubscribeByDevice(ctx context.Context, in *SubscribeBy
 	return out, nil
 }
 
+func (c *subscribeClient) ReplaceSubscribeByDevice(ctx context.Context, in *ReplaceSubscribeByDeviceRequest, opts ...grpc.CallOption) (*ReplaceSubscribeByDeviceResponse, error) {
+	out := new(ReplaceSubscribeByDeviceResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/ReplaceSubscribeByDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) ListSubscriptionsByEntity(ctx context.Context, in *ListSubscriptionsByEntityRequest, opts ...grpc.CallOption) (*ListSubscriptionsByEntityResponse, error) {
+	out := new(ListSubscriptionsByEntityResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/ListSubscriptionsByEntity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) ListSubscribeOperations(ctx context.Context, in *ListSubscribeOperationsRequest, opts ...grpc.CallOption) (*ListSubscribeOperationsResponse, error) {
+	out := new(ListSubscribeOperationsResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/ListSubscribeOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) GetSubscribeProgress(ctx context.Context, in *GetSubscribeProgressRequest, opts ...grpc.CallOption) (*GetSubscribeProgressResponse, error) {
+	out := new(GetSubscribeProgressResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/GetSubscribeProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*GetQuotaResponse, error) {
+	out := new(GetQuotaResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/GetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) ListDeletedSubscribes(ctx context.Context, in *ListDeletedSubscribesRequest, opts ...grpc.CallOption) (*ListDeletedSubscribesResponse, error) {
+	out := new(ListDeletedSubscribesResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/ListDeletedSubscribes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) RestoreSubscribe(ctx context.Context, in *RestoreSubscribeRequest, opts ...grpc.CallOption) (*RestoreSubscribeResponse, error) {
+	out := new(RestoreSubscribeResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/RestoreSubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) CreateSubscribeSelector(ctx context.Context, in *CreateSubscribeSelectorRequest, opts ...grpc.CallOption) (*CreateSubscribeSelectorResponse, error) {
+	out := new(CreateSubscribeSelectorResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/CreateSubscribeSelector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) ListSubscribeSelectors(ctx context.Context, in *ListSubscribeSelectorsRequest, opts ...grpc.CallOption) (*ListSubscribeSelectorsResponse, error) {
+	out := new(ListSubscribeSelectorsResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/ListSubscribeSelectors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) DeleteSubscribeSelector(ctx context.Context, in *DeleteSubscribeSelectorRequest, opts ...grpc.CallOption) (*DeleteSubscribeSelectorResponse, error) {
+	out := new(DeleteSubscribeSelectorResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/DeleteSubscribeSelector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) SyncSubscribeSelectors(ctx context.Context, in *SyncSubscribeSelectorsRequest, opts ...grpc.CallOption) (*SyncSubscribeSelectorsResponse, error) {
+	out := new(SyncSubscribeSelectorsResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/SyncSubscribeSelectors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) ExportSubscribes(ctx context.Context, in *ExportSubscribesRequest, opts ...grpc.CallOption) (*ExportSubscribesResponse, error) {
+	out := new(ExportSubscribesResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/ExportSubscribes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) ImportSubscribes(ctx context.Context, in *ImportSubscribesRequest, opts ...grpc.CallOption) (*ImportSubscribesResponse, error) {
+	out := new(ImportSubscribesResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/ImportSubscribes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) ListManifests(ctx context.Context, in *ListManifestsRequest, opts ...grpc.CallOption) (*ListManifestsResponse, error) {
+	out := new(ListManifestsResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/ListManifests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) PauseSubscribe(ctx context.Context, in *PauseSubscribeRequest, opts ...grpc.CallOption) (*PauseSubscribeResponse, error) {
+	out := new(PauseSubscribeResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/PauseSubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscribeClient) ResumeSubscribe(ctx context.Context, in *ResumeSubscribeRequest, opts ...grpc.CallOption) (*ResumeSubscribeResponse, error) {
+	out := new(ResumeSubscribeResponse)
+	err := c.cc.Invoke(ctx, "/api.subscribe.v1.Subscribe/ResumeSubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SubscribeServer is the server API for Subscribe service.
 // All implementations must embed UnimplementedSubscribeServer
 // for forward compatibility
@@ -165,7 +365,10 @@ type SubscribeServer interface {
 	SubscribeEntitiesByIDs(context.Context, *SubscribeEntitiesByIDsRequest) (*SubscribeEntitiesByIDsResponse, error)
 	SubscribeEntitiesByGroups(context.Context, *SubscribeEntitiesByGroupsRequest) (*SubscribeEntitiesByGroupsResponse, error)
 	SubscribeEntitiesByModels(context.Context, *SubscribeEntitiesByModelsRequest) (*SubscribeEntitiesByModelsResponse, error)
+	SubscribeEntitiesByQuery(context.Context, *SubscribeEntitiesByQueryRequest) (*SubscribeEntitiesByQueryResponse, error)
 	UnsubscribeEntitiesByIDs(context.Context, *UnsubscribeEntitiesByIDsRequest) (*UnsubscribeEntitiesByIDsResponse, error)
+	UnsubscribeEntitiesByGroups(context.Context, *UnsubscribeEntitiesByGroupsRequest) (*UnsubscribeEntitiesByGroupsResponse, error)
+	UnsubscribeEntitiesByModels(context.Context, *UnsubscribeEntitiesByModelsRequest) (*UnsubscribeEntitiesByModelsResponse, error)
 	ListSubscribeEntities(context.Context, *ListSubscribeEntitiesRequest) (*ListSubscribeEntitiesResponse, error)
 	CreateSubscribe(context.Context, *CreateSubscribeRequest) (*CreateSubscribeResponse, error)
 	UpdateSubscribe(context.Context, *UpdateSubscribeRequest) (*UpdateSubscribeResponse, error)
@@ -175,6 +378,23 @@ type SubscribeServer interface {
 	ChangeSubscribed(context.Context, *ChangeSubscribedRequest) (*ChangeSubscribedResponse, error)
 	ValidateSubscribed(context.Context, *ValidateSubscribedRequest) (*ValidateSubscribedResponse, error)
 	SubscribeByDevice(context.Context, *SubscribeByDeviceRequest) (*SubscribeByDeviceResponse, error)
+	ReplaceSubscribeByDevice(context.Context, *ReplaceSubscribeByDeviceRequest) (*ReplaceSubscribeByDeviceResponse, error)
+	ListSubscriptionsByEntity(context.Context, *ListSubscriptionsByEntityRequest) (*ListSubscriptionsByEntityResponse, error)
+	ListSubscribeOperations(context.Context, *ListSubscribeOperationsRequest) (*ListSubscribeOperationsResponse, error)
+	GetSubscribeProgress(context.Context, *GetSubscribeProgressRequest) (*GetSubscribeProgressResponse, error)
+	GetQuota(context.Context, *GetQuotaRequest) (*GetQuotaResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	ListDeletedSubscribes(context.Context, *ListDeletedSubscribesRequest) (*ListDeletedSubscribesResponse, error)
+	RestoreSubscribe(context.Context, *RestoreSubscribeRequest) (*RestoreSubscribeResponse, error)
+	CreateSubscribeSelector(context.Context, *CreateSubscribeSelectorRequest) (*CreateSubscribeSelectorResponse, error)
+	ListSubscribeSelectors(context.Context, *ListSubscribeSelectorsRequest) (*ListSubscribeSelectorsResponse, error)
+	DeleteSubscribeSelector(context.Context, *DeleteSubscribeSelectorRequest) (*DeleteSubscribeSelectorResponse, error)
+	SyncSubscribeSelectors(context.Context, *SyncSubscribeSelectorsRequest) (*SyncSubscribeSelectorsResponse, error)
+	ExportSubscribes(context.Context, *ExportSubscribesRequest) (*ExportSubscribesResponse, error)
+	ImportSubscribes(context.Context, *ImportSubscribesRequest) (*ImportSubscribesResponse, error)
+	ListManifests(context.Context, *ListManifestsRequest) (*ListManifestsResponse, error)
+	PauseSubscribe(context.Context, *PauseSubscribeRequest) (*PauseSubscribeResponse, error)
+	ResumeSubscribe(context.Context, *ResumeSubscribeRequest) (*ResumeSubscribeResponse, error)
 	mustEmbedUnimplementedSubscribeServer()
 }
 
@@ -191,9 +411,18 @@ func (UnimplementedSubscribeServer) SubscribeEntitiesByGroups(context.Context, *
 func (UnimplementedSubscribeServer) SubscribeEntitiesByModels(context.Context, *SubscribeEntitiesByModelsRequest) (*SubscribeEntitiesByModelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubscribeEntitiesByModels not implemented")
 }
+func (UnimplementedSubscribeServer) SubscribeEntitiesByQuery(context.Context, *SubscribeEntitiesByQueryRequest) (*SubscribeEntitiesByQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeEntitiesByQuery not implemented")
+}
 func (UnimplementedSubscribeServer) UnsubscribeEntitiesByIDs(context.Context, *UnsubscribeEntitiesByIDsRequest) (*UnsubscribeEntitiesByIDsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeEntitiesByIDs not implemented")
 }
+func (UnimplementedSubscribeServer) UnsubscribeEntitiesByGroups(context.Context, *UnsubscribeEntitiesByGroupsRequest) (*UnsubscribeEntitiesByGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeEntitiesByGroups not implemented")
+}
+func (UnimplementedSubscribeServer) UnsubscribeEntitiesByModels(context.Context, *UnsubscribeEntitiesByModelsRequest) (*UnsubscribeEntitiesByModelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeEntitiesByModels not implemented")
+}
 func (UnimplementedSubscribeServer) ListSubscribeEntities(context.Context, *ListSubscribeEntitiesRequest) (*ListSubscribeEntitiesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSubscribeEntities not implemented")
 }
@@ -221,6 +450,57 @@ func (UnimplementedSubscribeServer) ValidateSubscribed(context.Context, *Validat
 func (UnimplementedSubscribeServer) SubscribeByDevice(context.Context, *SubscribeByDeviceRequest) (*SubscribeByDeviceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubscribeByDevice not implemented")
 }
+func (UnimplementedSubscribeServer) ReplaceSubscribeByDevice(context.Context, *ReplaceSubscribeByDeviceRequest) (*ReplaceSubscribeByDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceSubscribeByDevice not implemented")
+}
+func (UnimplementedSubscribeServer) ListSubscriptionsByEntity(context.Context, *ListSubscriptionsByEntityRequest) (*ListSubscriptionsByEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptionsByEntity not implemented")
+}
+func (UnimplementedSubscribeServer) ListSubscribeOperations(context.Context, *ListSubscribeOperationsRequest) (*ListSubscribeOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscribeOperations not implemented")
+}
+func (UnimplementedSubscribeServer) GetSubscribeProgress(context.Context, *GetSubscribeProgressRequest) (*GetSubscribeProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscribeProgress not implemented")
+}
+func (UnimplementedSubscribeServer) GetQuota(context.Context, *GetQuotaRequest) (*GetQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuota not implemented")
+}
+func (UnimplementedSubscribeServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedSubscribeServer) ListDeletedSubscribes(context.Context, *ListDeletedSubscribesRequest) (*ListDeletedSubscribesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedSubscribes not implemented")
+}
+func (UnimplementedSubscribeServer) RestoreSubscribe(context.Context, *RestoreSubscribeRequest) (*RestoreSubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSubscribe not implemented")
+}
+func (UnimplementedSubscribeServer) CreateSubscribeSelector(context.Context, *CreateSubscribeSelectorRequest) (*CreateSubscribeSelectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscribeSelector not implemented")
+}
+func (UnimplementedSubscribeServer) ListSubscribeSelectors(context.Context, *ListSubscribeSelectorsRequest) (*ListSubscribeSelectorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscribeSelectors not implemented")
+}
+func (UnimplementedSubscribeServer) DeleteSubscribeSelector(context.Context, *DeleteSubscribeSelectorRequest) (*DeleteSubscribeSelectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscribeSelector not implemented")
+}
+func (UnimplementedSubscribeServer) SyncSubscribeSelectors(context.Context, *SyncSubscribeSelectorsRequest) (*SyncSubscribeSelectorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncSubscribeSelectors not implemented")
+}
+func (UnimplementedSubscribeServer) ExportSubscribes(context.Context, *ExportSubscribesRequest) (*ExportSubscribesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSubscribes not implemented")
+}
+func (UnimplementedSubscribeServer) ImportSubscribes(context.Context, *ImportSubscribesRequest) (*ImportSubscribesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSubscribes not implemented")
+}
+func (UnimplementedSubscribeServer) ListManifests(context.Context, *ListManifestsRequest) (*ListManifestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListManifests not implemented")
+}
+func (UnimplementedSubscribeServer) PauseSubscribe(context.Context, *PauseSubscribeRequest) (*PauseSubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSubscribe not implemented")
+}
+func (UnimplementedSubscribeServer) ResumeSubscribe(context.Context, *ResumeSubscribeRequest) (*ResumeSubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSubscribe not implemented")
+}
 func (UnimplementedSubscribeServer) mustEmbedUnimplementedSubscribeServer() {}
 
 // UnsafeSubscribeServer may be embedded to opt out of forward compatibility for this service.
@@ -288,6 +568,24 @@ func _Subscribe_SubscribeEntitiesByModels_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Subscribe_SubscribeEntitiesByQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeEntitiesByQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).SubscribeEntitiesByQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/SubscribeEntitiesByQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).SubscribeEntitiesByQuery(ctx, req.(*SubscribeEntitiesByQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Subscribe_UnsubscribeEntitiesByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UnsubscribeEntitiesByIDsRequest)
 	if err := dec(in); err != nil {
@@ -306,6 +604,42 @@ func _Subscribe_UnsubscribeEntitiesByIDs_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Subscribe_UnsubscribeEntitiesByGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeEntitiesByGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).UnsubscribeEntitiesByGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/UnsubscribeEntitiesByGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).UnsubscribeEntitiesByGroups(ctx, req.(*UnsubscribeEntitiesByGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_UnsubscribeEntitiesByModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeEntitiesByModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).UnsubscribeEntitiesByModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/UnsubscribeEntitiesByModels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).UnsubscribeEntitiesByModels(ctx, req.(*UnsubscribeEntitiesByModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Subscribe_ListSubscribeEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSubscribeEntitiesRequest)
 	if err := dec(in); err != nil {
@@ -468,6 +802,312 @@ func _Subscribe_SubscribeByDevice_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Subscribe_ReplaceSubscribeByDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceSubscribeByDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).ReplaceSubscribeByDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/ReplaceSubscribeByDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).ReplaceSubscribeByDevice(ctx, req.(*ReplaceSubscribeByDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_ListSubscriptionsByEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsByEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).ListSubscriptionsByEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/ListSubscriptionsByEntity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).ListSubscriptionsByEntity(ctx, req.(*ListSubscriptionsByEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_ListSubscribeOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscribeOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).ListSubscribeOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/ListSubscribeOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).ListSubscribeOperations(ctx, req.(*ListSubscribeOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_GetSubscribeProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscribeProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).GetSubscribeProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/GetSubscribeProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).GetSubscribeProgress(ctx, req.(*GetSubscribeProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_GetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).GetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/GetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).GetQuota(ctx, req.(*GetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_ListDeletedSubscribes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedSubscribesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).ListDeletedSubscribes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/ListDeletedSubscribes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).ListDeletedSubscribes(ctx, req.(*ListDeletedSubscribesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_RestoreSubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).RestoreSubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/RestoreSubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).RestoreSubscribe(ctx, req.(*RestoreSubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_CreateSubscribeSelector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubscribeSelectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).CreateSubscribeSelector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/CreateSubscribeSelector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).CreateSubscribeSelector(ctx, req.(*CreateSubscribeSelectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_ListSubscribeSelectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscribeSelectorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).ListSubscribeSelectors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/ListSubscribeSelectors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).ListSubscribeSelectors(ctx, req.(*ListSubscribeSelectorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_DeleteSubscribeSelector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscribeSelectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).DeleteSubscribeSelector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/DeleteSubscribeSelector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).DeleteSubscribeSelector(ctx, req.(*DeleteSubscribeSelectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_SyncSubscribeSelectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncSubscribeSelectorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).SyncSubscribeSelectors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/SyncSubscribeSelectors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).SyncSubscribeSelectors(ctx, req.(*SyncSubscribeSelectorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_ExportSubscribes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSubscribesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).ExportSubscribes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/ExportSubscribes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).ExportSubscribes(ctx, req.(*ExportSubscribesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_ImportSubscribes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSubscribesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).ImportSubscribes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/ImportSubscribes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).ImportSubscribes(ctx, req.(*ImportSubscribesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_ListManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListManifestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).ListManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/ListManifests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).ListManifests(ctx, req.(*ListManifestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_PauseSubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).PauseSubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/PauseSubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).PauseSubscribe(ctx, req.(*PauseSubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Subscribe_ResumeSubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscribeServer).ResumeSubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.subscribe.v1.Subscribe/ResumeSubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscribeServer).ResumeSubscribe(ctx, req.(*ResumeSubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Subscribe_ServiceDesc is the grpc.ServiceDesc for Subscribe service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -487,10 +1127,22 @@ var Subscribe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubscribeEntitiesByModels",
 			Handler:    _Subscribe_SubscribeEntitiesByModels_Handler,
 		},
+		{
+			MethodName: "SubscribeEntitiesByQuery",
+			Handler:    _Subscribe_SubscribeEntitiesByQuery_Handler,
+		},
 		{
 			MethodName: "UnsubscribeEntitiesByIDs",
 			Handler:    _Subscribe_UnsubscribeEntitiesByIDs_Handler,
 		},
+		{
+			MethodName: "UnsubscribeEntitiesByGroups",
+			Handler:    _Subscribe_UnsubscribeEntitiesByGroups_Handler,
+		},
+		{
+			MethodName: "UnsubscribeEntitiesByModels",
+			Handler:    _Subscribe_UnsubscribeEntitiesByModels_Handler,
+		},
 		{
 			MethodName: "ListSubscribeEntities",
 			Handler:    _Subscribe_ListSubscribeEntities_Handler,
@@ -527,6 +1179,74 @@ var Subscribe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubscribeByDevice",
 			Handler:    _Subscribe_SubscribeByDevice_Handler,
 		},
+		{
+			MethodName: "ReplaceSubscribeByDevice",
+			Handler:    _Subscribe_ReplaceSubscribeByDevice_Handler,
+		},
+		{
+			MethodName: "ListSubscriptionsByEntity",
+			Handler:    _Subscribe_ListSubscriptionsByEntity_Handler,
+		},
+		{
+			MethodName: "ListSubscribeOperations",
+			Handler:    _Subscribe_ListSubscribeOperations_Handler,
+		},
+		{
+			MethodName: "GetSubscribeProgress",
+			Handler:    _Subscribe_GetSubscribeProgress_Handler,
+		},
+		{
+			MethodName: "GetQuota",
+			Handler:    _Subscribe_GetQuota_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Subscribe_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "ListDeletedSubscribes",
+			Handler:    _Subscribe_ListDeletedSubscribes_Handler,
+		},
+		{
+			MethodName: "RestoreSubscribe",
+			Handler:    _Subscribe_RestoreSubscribe_Handler,
+		},
+		{
+			MethodName: "CreateSubscribeSelector",
+			Handler:    _Subscribe_CreateSubscribeSelector_Handler,
+		},
+		{
+			MethodName: "ListSubscribeSelectors",
+			Handler:    _Subscribe_ListSubscribeSelectors_Handler,
+		},
+		{
+			MethodName: "DeleteSubscribeSelector",
+			Handler:    _Subscribe_DeleteSubscribeSelector_Handler,
+		},
+		{
+			MethodName: "SyncSubscribeSelectors",
+			Handler:    _Subscribe_SyncSubscribeSelectors_Handler,
+		},
+		{
+			MethodName: "ExportSubscribes",
+			Handler:    _Subscribe_ExportSubscribes_Handler,
+		},
+		{
+			MethodName: "ImportSubscribes",
+			Handler:    _Subscribe_ImportSubscribes_Handler,
+		},
+		{
+			MethodName: "ListManifests",
+			Handler:    _Subscribe_ListManifests_Handler,
+		},
+		{
+			MethodName: "PauseSubscribe",
+			Handler:    _Subscribe_PauseSubscribe_Handler,
+		},
+		{
+			MethodName: "ResumeSubscribe",
+			Handler:    _Subscribe_ResumeSubscribe_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/subscribe/v1/subscribe.proto",
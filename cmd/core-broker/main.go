@@ -57,6 +57,13 @@ func init() {
 func main() {
 	flag.Parse()
 
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(flag.Args()[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	httpSrv := server.NewHTTPServer(HTTPAddr)
 	grpcSrv := server.NewGRPCServer(GRPCAddr)
 	serverList := []transport.Server{httpSrv, grpcSrv}
@@ -88,6 +95,7 @@ func main() {
 		Dapr_v1.RegisterSubscribeServer(grpcSrv.GetServe(), DaprSubscribeSrv)
 
 		SubscribeSrv := service.NewSubscribeService()
+		go SubscribeSrv.Run()
 		Subscribe_v1.RegisterSubscribeHTTPServer(httpSrv.Container, SubscribeSrv)
 		Subscribe_v1.RegisterSubscribeServer(grpcSrv.GetServe(), SubscribeSrv)
 	}
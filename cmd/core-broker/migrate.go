@@ -0,0 +1,75 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/model"
+)
+
+const migrateUsage = `usage: core-broker migrate <command> [flags]
+
+commands:
+  up       apply all pending migrations.
+  down     roll back the last applied migrations.
+  status   list the migrations and whether they are applied.
+`
+
+// runMigrate runs the "migrate" subcommand on the database of the DSN environment variable.
+func runMigrate(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, migrateUsage)
+		return errors.New("missing migrate command")
+	}
+	command := args[0]
+	if command != "up" && command != "down" && command != "status" {
+		fmt.Fprint(os.Stderr, migrateUsage)
+		return errors.Errorf("unknown migrate command %q", command)
+	}
+	fs := flag.NewFlagSet("migrate "+command, flag.ExitOnError)
+	steps := fs.Int("steps", 1, "number of migrations rolled back by down.")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	if err := model.Open(); err != nil {
+		return errors.Wrap(err, "open database")
+	}
+
+	switch command {
+	case "up":
+		done, err := model.MigrateUp()
+		for _, m := range done {
+			fmt.Printf("applied %d %s\n", m.Version, m.Description)
+		}
+		if err == nil && len(done) == 0 {
+			fmt.Println("schema is up to date")
+		}
+		return err
+	case "down":
+		done, err := model.MigrateDown(*steps)
+		for _, m := range done {
+			fmt.Printf("rolled back %d %s\n", m.Version, m.Description)
+		}
+		return err
+	case "status":
+		statuses, err := model.MigrationStatuses()
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tDESCRIPTION\tAPPLIED AT")
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.Applied {
+				appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.Description, appliedAt)
+		}
+		return w.Flush()
+	}
+	return nil
+}
@@ -16,14 +16,19 @@ const (
 )
 
 var (
-	ErrNotFound = errors.New("authorization info not found")
+	ErrNotFound       = errors.New("authorization info not found")
+	ErrTenantNotFound = errors.New("tenant not found in authorization info")
 )
 
+// RoleAdmin is the role of the administrators of a tenant.
+const RoleAdmin = "admin"
+
 type User struct {
-	ID    string `json:"id"`
-	Role  string `json:"role"`
-	Token string `json:"token"`
-	Auth  string `json:"auth"`
+	ID     string `json:"id"`
+	Tenant string `json:"tenant"`
+	Role   string `json:"role"`
+	Token  string `json:"token"`
+	Auth   string `json:"auth"`
 }
 
 func GetUser(ctx context.Context) (User, error) {
@@ -46,6 +51,11 @@ func GetUser(ctx context.Context) (User, error) {
 		return u, err
 	}
 	u.ID = q.Get("user")
+	u.Tenant = q.Get("tenant")
+	if u.Tenant == "" {
+		// every query is scoped by the tenant, a user without tenant must not see the others
+		return u, ErrTenantNotFound
+	}
 	u.Role = q.Get("role")
 	token, ok := headers[_AuthorizationHeader]
 	if ok {
@@ -53,3 +63,8 @@ func GetUser(ctx context.Context) (User, error) {
 	}
 	return u, nil
 }
+
+// TenantAdmin tells whether the user administers its tenant.
+func (u User) TenantAdmin() bool {
+	return u.Role == RoleAdmin
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	tkeelTransutil "github.com/tkeel-io/kit/transport/http"
+)
+
+func TestGetUser(t *testing.T) {
+	tests := []struct {
+		name     string
+		auth     string
+		excepted User
+		err      error
+	}{
+		{"with tenant", "tenant=t1&user=u1&role=admin", User{ID: "u1", Tenant: "t1", Role: "admin"}, nil},
+		{"without tenant", "user=u1&role=admin", User{}, ErrTenantNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth := base64.StdEncoding.EncodeToString([]byte(tt.auth))
+			header := http.Header{}
+			header.Set(_XtKeelAuthUserHeader, auth)
+			u, err := GetUser(tkeelTransutil.ContextWithHeader(context.Background(), header))
+			if tt.err != nil {
+				assert.True(t, errors.Is(err, tt.err), err)
+				return
+			}
+			assert.NoError(t, err)
+			tt.excepted.Auth = auth
+			assert.Equal(t, tt.excepted, u)
+		})
+	}
+}
+
+func TestTenantAdmin(t *testing.T) {
+	assert.True(t, User{Role: RoleAdmin}.TenantAdmin())
+	assert.False(t, User{Role: "user"}.TenantAdmin())
+	assert.False(t, User{}.TenantAdmin())
+}
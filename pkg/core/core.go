@@ -84,6 +84,14 @@ func (c *Client) Unsubscribe(subscriptionID string) error {
 	return nil
 }
 
+// Publish publishes data to topic of the broker pubsub.
+func (c *Client) Publish(topic string, data interface{}) error {
+	if err := c.daprClient.PublishEvent(context.Background(), types.PubsubName, topic, data); err != nil {
+		return errors.Wrap(err, "publish event error")
+	}
+	return nil
+}
+
 const _InsertQueryTemplate = "insert into %s select %s.*"
 
 func IntoFilterQuery(to string, from string) string {
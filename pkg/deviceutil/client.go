@@ -127,6 +127,16 @@ func GroupQuery(value string, values ...string) ConditionQuery {
 	}
 }
 
+// ParentQuery selects the direct children of the group, unlike GroupQuery which also selects
+// those of its subgroups.
+func ParentQuery(value string) ConditionQuery {
+	return ConditionQuery{
+		Field:    "basicInfo.parentId",
+		Operator: "$eq",
+		Value:    value,
+	}
+}
+
 func GroupTypeQuery() ConditionQuery {
 	return ConditionQuery{
 		Field:    "type",
@@ -171,6 +181,18 @@ func DeviceQuery(id string) ConditionQuery {
 	}
 }
 
+func DevicesQuery(id string, ids ...string) ConditionQuery {
+	if len(ids) != 0 {
+		ids = append([]string{id}, ids...)
+		id = strings.Join(ids, ",")
+	}
+	return ConditionQuery{
+		Field:    "id",
+		Operator: "$eq",
+		Value:    id,
+	}
+}
+
 func EqQuery(field, value string) ConditionQuery {
 	return ConditionQuery{
 		Field:    field,
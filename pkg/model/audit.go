@@ -0,0 +1,173 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Actions recorded in the audit log.
+const (
+	AuditCreateSubscribe     = "create_subscribe"
+	AuditUpdateSubscribe     = "update_subscribe"
+	AuditDeleteSubscribe     = "delete_subscribe"
+	AuditSubscribeEntities   = "subscribe_entities"
+	AuditUnsubscribeEntities = "unsubscribe_entities"
+	AuditChangeSubscribed    = "change_subscribed"
+	AuditSubscribeByDevice   = "subscribe_by_device"
+	AuditRestoreSubscribe    = "restore_subscribe"
+	AuditCreateSelector      = "create_selector"
+	AuditDeleteSelector      = "delete_selector"
+	AuditSyncSelector        = "sync_selector"
+	AuditImportSubscribe     = "import_subscribe"
+	AuditApplyManifest       = "apply_manifest"
+	AuditPauseSubscribe      = "pause_subscribe"
+	AuditResumeSubscribe     = "resume_subscribe"
+)
+
+// AuditSystemActor is the actor of the changes made by the broker itself, like the sync of the selectors.
+const AuditSystemActor = "_system"
+
+// Outcomes of an audited action.
+const (
+	AuditSuccess = "success"
+	AuditFailure = "failure"
+)
+
+// AuditEvent records a change of the subscriptions, Before and After are JSON documents.
+type AuditEvent struct {
+	ID          uint      `gorm:"primarykey"`
+	CreatedAt   time.Time `gorm:"index"`
+	TenantID    string    `gorm:"index;size:255;not null"`
+	Actor       string    `gorm:"index;size:255;not null"`
+	Action      string    `gorm:"size:64;not null"`
+	SubscribeID uint      `gorm:"index"`
+	Before      string    `gorm:"type:text"`
+	After       string    `gorm:"type:text"`
+	Outcome     string    `gorm:"size:32;not null"`
+	Error       string    `gorm:"size:1024"`
+
+	Entities []AuditEntity
+}
+
+// AuditEntity is an entity changed by an AuditEvent.
+type AuditEntity struct {
+	ID           uint   `gorm:"primarykey"`
+	AuditEventID uint   `gorm:"index;not null"`
+	EntityID     string `gorm:"index;size:255;not null"`
+}
+
+// AuditFilter selects the audit events of a tenant, zero fields do not filter. UserID selects the events of
+// the subscriptions of the user, deleted ones included, and the events the user made.
+type AuditFilter struct {
+	TenantID    string
+	UserID      string
+	SubscribeID uint
+	EntityID    string
+	Actor       string
+	Start       time.Time
+	End         time.Time
+}
+
+// NewAuditEvent returns the event of action, before and after are encoded to JSON and nil is recorded empty.
+// The outcome is a failure when err is not nil.
+func NewAuditEvent(tenantID, actor, action string, subscribeID uint, before, after interface{}, err error) (*AuditEvent, error) {
+	e := &AuditEvent{
+		TenantID:    tenantID,
+		Actor:       actor,
+		Action:      action,
+		SubscribeID: subscribeID,
+		Outcome:     AuditSuccess,
+	}
+	if err != nil {
+		e.Outcome = AuditFailure
+		e.Error = truncate(err.Error(), 1024)
+	}
+	var encodeErr error
+	if e.Before, encodeErr = encodeAuditValue(before); encodeErr != nil {
+		return nil, encodeErr
+	}
+	if e.After, encodeErr = encodeAuditValue(after); encodeErr != nil {
+		return nil, encodeErr
+	}
+	return e, nil
+}
+
+func encodeAuditValue(v interface{}) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", errors.Wrap(err, "encode audit value")
+	}
+	return string(b), nil
+}
+
+// RecordAuditEvent saves the event and the entities it changed.
+func RecordAuditEvent(e *AuditEvent, entityIDs []string) error {
+	return DB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(e).Error; err != nil {
+			return errors.Wrap(err, "record audit event")
+		}
+		entityIDs = uniqueStrings(entityIDs)
+		if len(entityIDs) == 0 {
+			return nil
+		}
+		entities := make([]AuditEntity, 0, len(entityIDs))
+		for _, id := range entityIDs {
+			entities = append(entities, AuditEntity{AuditEventID: e.ID, EntityID: id})
+		}
+		if err := tx.CreateInBatches(&entities, DefaultBulkBatchSize).Error; err != nil {
+			return errors.Wrap(err, "record audit entities")
+		}
+		e.Entities = entities
+		return nil
+	})
+}
+
+// ListAuditEvents returns a page of the events selected by filter, the latest first, and how many are selected.
+// The entities of the events are loaded.
+func ListAuditEvents(filter AuditFilter, page pagination.Page) ([]AuditEvent, int64, error) {
+	query := DB().Model(&AuditEvent{}).Where("tenant_id = ?", filter.TenantID)
+	if filter.UserID != "" {
+		owned := DB().Unscoped().Model(&Subscribe{}).Select("id").
+			Where("tenant_id = ? AND user_id = ?", filter.TenantID, filter.UserID)
+		query = query.Where("(actor = ? OR subscribe_id IN (?))", filter.UserID, owned)
+	}
+	if filter.SubscribeID != 0 {
+		query = query.Where("subscribe_id = ?", filter.SubscribeID)
+	}
+	if filter.EntityID != "" {
+		query = query.Where("id IN (?)", DB().Model(&AuditEntity{}).Select("audit_event_id").Where("entity_id = ?", filter.EntityID))
+	}
+	if filter.Actor != "" {
+		query = query.Where("actor = ?", filter.Actor)
+	}
+	if !filter.Start.IsZero() {
+		query = query.Where("created_at >= ?", filter.Start)
+	}
+	if !filter.End.IsZero() {
+		query = query.Where("created_at < ?", filter.End)
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "count audit events")
+	}
+	events := make([]AuditEvent, 0)
+	query = query.Preload("Entities").Order("id DESC")
+	if page.Cursor != nil {
+		query = query.Where("id < ?", page.Cursor.ID).Limit(int(page.Limit()))
+	} else if page.Required() {
+		query = query.Limit(int(page.Limit())).Offset(int(page.Offset()))
+	}
+	if err := query.Find(&events).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "list audit events")
+	}
+	return events, total, nil
+}
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/pagination"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	useTestDB(t)
+
+	record := func(tenant, actor, action string, subscribeID uint, entityIDs []string, err error) {
+		e, encodeErr := NewAuditEvent(tenant, actor, action, subscribeID, nil, map[string]int{"entities": len(entityIDs)}, err)
+		assert.NoError(t, encodeErr)
+		assert.NoError(t, RecordAuditEvent(e, entityIDs))
+	}
+	record("tenant", "alice", AuditSubscribeEntities, 1, []string{"d1", "d2", "d1"}, nil)
+	record("tenant", "bob", AuditUnsubscribeEntities, 1, []string{"d1"}, errors.New("boom"))
+	record("tenant", "alice", AuditCreateSubscribe, 2, nil, nil)
+	record("other", "alice", AuditSubscribeEntities, 3, []string{"d1"}, nil)
+
+	tests := []struct {
+		name     string
+		filter   AuditFilter
+		excepted []string
+	}{
+		{"tenant", AuditFilter{TenantID: "tenant"}, []string{AuditCreateSubscribe, AuditUnsubscribeEntities, AuditSubscribeEntities}},
+		{"subscribe", AuditFilter{TenantID: "tenant", SubscribeID: 2}, []string{AuditCreateSubscribe}},
+		{"entity", AuditFilter{TenantID: "tenant", EntityID: "d1"}, []string{AuditUnsubscribeEntities, AuditSubscribeEntities}},
+		{"actor", AuditFilter{TenantID: "tenant", Actor: "bob"}, []string{AuditUnsubscribeEntities}},
+		{"time range", AuditFilter{TenantID: "tenant", End: time.Now().Add(-time.Hour)}, []string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			events, total, err := ListAuditEvents(test.filter, pagination.Page{})
+			assert.NoError(t, err)
+			assert.Equal(t, int64(len(test.excepted)), total)
+			actions := make([]string, 0, len(events))
+			for _, e := range events {
+				actions = append(actions, e.Action)
+			}
+			assert.Equal(t, test.excepted, actions)
+		})
+	}
+
+	events, total, err := ListAuditEvents(AuditFilter{TenantID: "tenant", EntityID: "d1"}, pagination.Page{Num: 2, Size: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Len(t, events, 1)
+	assert.Equal(t, AuditSubscribeEntities, events[0].Action)
+	assert.Len(t, events[0].Entities, 2, "duplicated entities are recorded once")
+	assert.Equal(t, `{"entities":3}`, events[0].After)
+
+	events, _, err = ListAuditEvents(AuditFilter{TenantID: "tenant", Actor: "bob"}, pagination.Page{})
+	assert.NoError(t, err)
+	assert.Equal(t, AuditFailure, events[0].Outcome)
+	assert.Equal(t, "boom", events[0].Error)
+}
+
+func TestListAuditEventsOfUser(t *testing.T) {
+	useTestDB(t)
+
+	alice := Subscribe{TenantID: "tenant", UserID: "alice", Title: "alice"}
+	assert.NoError(t, DB().Create(&alice).Error)
+	bob := Subscribe{TenantID: "tenant", UserID: "bob", Title: "bob"}
+	assert.NoError(t, DB().Create(&bob).Error)
+	deleted := Subscribe{TenantID: "tenant", UserID: "alice", Title: "deleted"}
+	assert.NoError(t, DB().Create(&deleted).Error)
+	assert.NoError(t, DB().Delete(&deleted).Error)
+
+	record := func(actor, action string, subscribeID uint) {
+		e, err := NewAuditEvent("tenant", actor, action, subscribeID, nil, nil, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, RecordAuditEvent(e, nil))
+	}
+	record("alice", AuditCreateSubscribe, alice.ID)
+	record("bob", AuditCreateSubscribe, bob.ID)
+	record(AuditSystemActor, AuditSyncSelector, alice.ID)
+	record(AuditSystemActor, AuditSyncSelector, bob.ID)
+	record("alice", AuditDeleteSubscribe, deleted.ID)
+	record("alice", AuditUpdateSubscribe, bob.ID) // a failed attempt on the subscription of bob
+
+	tests := []struct {
+		name     string
+		filter   AuditFilter
+		excepted []string
+	}{
+		{"alice", AuditFilter{TenantID: "tenant", UserID: "alice"}, []string{AuditUpdateSubscribe, AuditDeleteSubscribe, AuditSyncSelector, AuditCreateSubscribe}},
+		{"bob", AuditFilter{TenantID: "tenant", UserID: "bob"}, []string{AuditUpdateSubscribe, AuditSyncSelector, AuditCreateSubscribe}},
+		{"subscribe of another user", AuditFilter{TenantID: "tenant", UserID: "bob", SubscribeID: alice.ID}, []string{}},
+		{"another tenant", AuditFilter{TenantID: "other", UserID: "alice"}, []string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			events, total, err := ListAuditEvents(test.filter, pagination.Page{})
+			assert.NoError(t, err)
+			assert.Equal(t, int64(len(test.excepted)), total)
+			actions := make([]string, 0, len(events))
+			for _, e := range events {
+				actions = append(actions, e.Action)
+			}
+			assert.Equal(t, test.excepted, actions)
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package model
+
+import (
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultBulkBatchSize is the number of entities inserted by one statement of the bulk pipeline.
+const DefaultBulkBatchSize = 500
+
+// Results of an entity in a bulk change.
+const (
+	ResultCreated       = "created"
+	ResultExisting      = "existing"
+	ResultRemoved       = "removed"
+	ResultMoved         = "moved"
+	ResultCopied        = "copied"
+	ResultNotSubscribed = "not_subscribed"
+	ResultNotFound      = "not_found"
+	ResultForbidden     = "forbidden"
+	ResultFailed        = "failed"
+)
+
+// EntityResult tells what a bulk change did to an entity, Reason explains a failure.
+type EntityResult struct {
+	EntityID string
+	Result   string
+	Reason   string
+}
+
+// BulkSummary reports the progress of a bulk subscription.
+type BulkSummary struct {
+	Total     int
+	Created   int
+	Existing  int
+	Failed    int
+	NotFound  int
+	Forbidden int
+}
+
+// Processed returns how many entities of the summary have been handled.
+func (s BulkSummary) Processed() int {
+	return s.Created + s.Existing + s.Failed + s.NotFound + s.Forbidden
+}
+
+// Succeeded tells whether every entity of the summary is subscribed.
+func (s BulkSummary) Succeeded() bool {
+	return s.Created+s.Existing == s.Total
+}
+
+// Add counts result in the summary, the entity is already counted in Total.
+func (s *BulkSummary) Add(result EntityResult) {
+	switch result.Result {
+	case ResultCreated:
+		s.Created++
+	case ResultExisting:
+		s.Existing++
+	case ResultNotFound:
+		s.NotFound++
+	case ResultForbidden:
+		s.Forbidden++
+	default:
+		s.Failed++
+	}
+}
+
+// SubscribeEntitiesInBatches adds the entities to the subscription batchSize entities at a time.
+// Every batch and the outbox rows of its core operations are inserted in one transaction,
+// the core operations are applied afterwards by the OutboxDispatcher.
+// progress, when not nil, is called after every batch. The result of every entity is returned in the order of entityIDs,
+// a relation created concurrently by another request is reported as created.
+func SubscribeEntitiesInBatches(subscribe *Subscribe, entityIDs []string, batchSize int, progress func(BulkSummary)) (BulkSummary, []EntityResult, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultBulkBatchSize
+	}
+	entityIDs = uniqueStrings(entityIDs)
+	summary := BulkSummary{Total: len(entityIDs)}
+	results := make([]EntityResult, 0, len(entityIDs))
+
+	var firstErr error
+	for start := 0; start < len(entityIDs); start += batchSize {
+		end := start + batchSize
+		if end > len(entityIDs) {
+			end = len(entityIDs)
+		}
+		batch := entityIDs[start:end]
+		var created []string
+		err := DB().Transaction(func(tx *gorm.DB) (err error) {
+			created, err = subscribeBatch(tx, subscribe, batch)
+			return err
+		})
+		if err != nil {
+			log.Errorf("subscribe entities %d-%d of subscribe %d err: %v", start, end, subscribe.ID, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		for _, result := range batchResults(batch, created, err) {
+			summary.Add(result)
+			results = append(results, result)
+		}
+		if progress != nil {
+			progress(summary)
+		}
+	}
+	return summary, results, firstErr
+}
+
+// PlanSubscribeEntities returns the summary and the results SubscribeEntitiesInBatches would return
+// for the entities, without changing anything.
+func PlanSubscribeEntities(subscribe *Subscribe, entityIDs []string) (BulkSummary, []EntityResult, error) {
+	entityIDs = uniqueStrings(entityIDs)
+	summary := BulkSummary{Total: len(entityIDs)}
+	results := make([]EntityResult, 0, len(entityIDs))
+	for _, batch := range chunkStrings(entityIDs, DefaultBulkBatchSize) {
+		existing := make([]string, 0)
+		if err := DB().Model(&SubscribeEntities{}).
+			Where("subscribe_id = ? AND entity_id IN ?", subscribe.ID, batch).
+			Pluck("entity_id", &existing).Error; err != nil {
+			return summary, nil, errors.Wrap(err, "query subscribed entities")
+		}
+		created, _ := diffStrings(batch, existing)
+		for _, result := range batchResults(batch, created, nil) {
+			summary.Add(result)
+			results = append(results, result)
+		}
+	}
+	return summary, results, nil
+}
+
+// batchResults returns the result of every entity of a batch which created the relations of created or failed with err.
+func batchResults(batch, created []string, err error) []EntityResult {
+	results := make([]EntityResult, 0, len(batch))
+	if err != nil {
+		for _, id := range batch {
+			results = append(results, EntityResult{EntityID: id, Result: ResultFailed, Reason: err.Error()})
+		}
+		return results
+	}
+	isCreated := make(map[string]bool, len(created))
+	for _, id := range created {
+		isCreated[id] = true
+	}
+	for _, id := range batch {
+		result := EntityResult{EntityID: id, Result: ResultExisting}
+		if isCreated[id] {
+			result.Result = ResultCreated
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// subscribeBatch inserts, in the transaction tx, the relations of the entities which are not subscribed yet
+// and the outbox rows of their core operations, and returns the entities whose relations were created.
+func subscribeBatch(tx *gorm.DB, subscribe *Subscribe, entityIDs []string) ([]string, error) {
+	existing := make([]string, 0)
+	if err := tx.Model(&SubscribeEntities{}).
+		Where("subscribe_id = ? AND entity_id IN ?", subscribe.ID, entityIDs).
+		Pluck("entity_id", &existing).Error; err != nil {
+		return nil, errors.Wrap(err, "query subscribed entities")
+	}
+	subscribed := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		subscribed[id] = true
+	}
+
+	created := make([]string, 0, len(entityIDs))
+	records := make([]SubscribeEntities, 0, len(entityIDs))
+	for _, id := range entityIDs {
+		if subscribed[id] {
+			continue
+		}
+		created = append(created, id)
+		records = append(records, SubscribeEntities{
+			TenantID:    subscribe.TenantID,
+			EntityID:    id,
+			UniqueKey:   subscribeuril.GenerateSubscribeTopic(subscribe.ID, id),
+			SubscribeID: subscribe.ID,
+		})
+	}
+	if len(records) == 0 {
+		return created, nil
+	}
+
+	// The outbox rows are written here in bulk, so the per row hooks are skipped.
+	// A relation created concurrently is left alone, its extra operation is idempotent.
+	// A paused subscription records no outbox row, ResumeSubscribe does.
+	if err := lockSubscribeStatus(tx, subscribe); err != nil {
+		return nil, err
+	}
+	result := tx.Session(&gorm.Session{SkipHooks: true}).
+		Clauses(clause.OnConflict{DoNothing: true}).Omit(clause.Associations).Create(&records)
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "insert subscribe entities")
+	}
+	if subscribe.Paused() {
+		return created, nil
+	}
+	if err := recordOutbox(tx, subscribe, created, OperationSubscribe); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UnsubscribeEntities removes the entities from the subscription in one transaction, and records the outbox rows
+// of their core operations. The result of every entity is returned in the order of entityIDs.
+func UnsubscribeEntities(subscribe *Subscribe, entityIDs []string) ([]EntityResult, error) {
+	entityIDs = uniqueStrings(entityIDs)
+	results := make([]EntityResult, 0, len(entityIDs))
+	err := DB().Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(entityIDs); start += DefaultBulkBatchSize {
+			end := start + DefaultBulkBatchSize
+			if end > len(entityIDs) {
+				end = len(entityIDs)
+			}
+			removed, err := unsubscribeBatch(tx, subscribe, entityIDs[start:end])
+			if err != nil {
+				return err
+			}
+			isRemoved := make(map[string]bool, len(removed))
+			for _, id := range removed {
+				isRemoved[id] = true
+			}
+			for _, id := range entityIDs[start:end] {
+				result := EntityResult{EntityID: id, Result: ResultNotSubscribed}
+				if isRemoved[id] {
+					result.Result = ResultRemoved
+				}
+				results = append(results, result)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// PlanUnsubscribeEntities returns the results UnsubscribeEntities would return for the entities, without changing anything.
+func PlanUnsubscribeEntities(subscribe *Subscribe, entityIDs []string) ([]EntityResult, error) {
+	entityIDs = uniqueStrings(entityIDs)
+	results := make([]EntityResult, 0, len(entityIDs))
+	for _, batch := range chunkStrings(entityIDs, DefaultBulkBatchSize) {
+		subscribed := make([]string, 0)
+		if err := DB().Model(&SubscribeEntities{}).
+			Where("subscribe_id = ? AND entity_id IN ?", subscribe.ID, batch).
+			Pluck("entity_id", &subscribed).Error; err != nil {
+			return nil, errors.Wrap(err, "query subscribed entities")
+		}
+		isSubscribed := make(map[string]bool, len(subscribed))
+		for _, id := range subscribed {
+			isSubscribed[id] = true
+		}
+		for _, id := range batch {
+			result := EntityResult{EntityID: id, Result: ResultNotSubscribed}
+			if isSubscribed[id] {
+				result.Result = ResultRemoved
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// unsubscribeBatch deletes, in the transaction tx, the relations of the subscribed entities and records
+// the outbox rows of their core operations, and returns the entities whose relations were deleted.
+func unsubscribeBatch(tx *gorm.DB, subscribe *Subscribe, entityIDs []string) ([]string, error) {
+	removed := make([]string, 0, len(entityIDs))
+	if err := tx.Model(&SubscribeEntities{}).
+		Where("subscribe_id = ? AND entity_id IN ?", subscribe.ID, entityIDs).
+		Pluck("entity_id", &removed).Error; err != nil {
+		return nil, errors.Wrap(err, "query subscribed entities")
+	}
+	if len(removed) == 0 {
+		return removed, nil
+	}
+
+	// as in subscribeBatch, the outbox rows are written here in bulk
+	if err := lockSubscribeStatus(tx, subscribe); err != nil {
+		return nil, err
+	}
+	if err := tx.Session(&gorm.Session{NewDB: true, SkipHooks: true}).
+		Where("subscribe_id = ? AND entity_id IN ?", subscribe.ID, removed).
+		Delete(&SubscribeEntities{}).Error; err != nil {
+		return nil, errors.Wrap(err, "delete subscribe entities")
+	}
+	// the selectors holding the entities attach them again at their next sync if they still select them
+	if err := tx.Session(&gorm.Session{NewDB: true}).
+		Where("subscribe_id = ? AND entity_id IN ?", subscribe.ID, removed).
+		Delete(&SelectorEntity{}).Error; err != nil {
+		return nil, errors.Wrap(err, "release selector entities")
+	}
+	if subscribe.Paused() {
+		return removed, nil
+	}
+	if err := recordOutbox(tx, subscribe, removed, OperationUnsubscribe); err != nil {
+		return nil, err
+	}
+	return removed, nil
+}
+
+func uniqueStrings(items []string) []string {
+	seen := make(map[string]bool, len(items))
+	unique := make([]string, 0, len(items))
+	for _, item := range items {
+		if item == "" || seen[item] {
+			continue
+		}
+		seen[item] = true
+		unique = append(unique, item)
+	}
+	return unique
+}
+
+// OperationCounts counts the outbox operations of a subscription by status.
+type OperationCounts struct {
+	Pending int64
+	Done    int64
+	Failed  int64
+}
+
+// SubscribeOperationCounts reports how far the core operations of the subscription have been applied. The done
+// operations older than the outbox retention are purged, so Done only counts those within the retention.
+func SubscribeOperationCounts(subscribeID uint) (OperationCounts, error) {
+	rows := make([]struct {
+		Status string
+		Count  int64
+	}, 0)
+	counts := OperationCounts{}
+	if err := DB().Model(&Outbox{}).Select("status, count(*) AS count").
+		Where("subscribe_id = ?", subscribeID).Group("status").Scan(&rows).Error; err != nil {
+		return counts, errors.Wrap(err, "count subscribe operations")
+	}
+	for _, row := range rows {
+		switch row.Status {
+		case OutboxPending, OutboxRunning:
+			counts.Pending += row.Count
+		case OutboxDone:
+			counts.Done = row.Count
+		case OutboxFailed:
+			counts.Failed = row.Count
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,126 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUniqueStrings(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []string
+		excepted []string
+	}{
+		{"empty", nil, []string{}},
+		{"keep order", []string{"b", "a", "c"}, []string{"b", "a", "c"}},
+		{"drop duplicates and blanks", []string{"a", "", "b", "a", "b"}, []string{"a", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.excepted, uniqueStrings(tt.items))
+		})
+	}
+}
+
+func TestSubscribeEntitiesInBatches(t *testing.T) {
+	useTestDB(t)
+
+	subscribe := Subscribe{Title: "bulk", Endpoint: "endpoint"}
+	assert.NoError(t, DB().Create(&subscribe).Error)
+	assert.NoError(t, DB().Create(&SubscribeEntities{EntityID: "d1", UniqueKey: "1-d1", SubscribeID: subscribe.ID}).Error)
+
+	batches := 0
+	summary, results, err := SubscribeEntitiesInBatches(&subscribe, []string{"d1", "d2", "d3", "d2", "d4", "d5"}, 2, func(BulkSummary) {
+		batches++
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, BulkSummary{Total: 5, Created: 4, Existing: 1}, summary)
+	assert.Equal(t, 3, batches)
+	assert.Equal(t, []EntityResult{
+		{EntityID: "d1", Result: ResultExisting},
+		{EntityID: "d2", Result: ResultCreated},
+		{EntityID: "d3", Result: ResultCreated},
+		{EntityID: "d4", Result: ResultCreated},
+		{EntityID: "d5", Result: ResultCreated},
+	}, results)
+
+	var entities, operations int64
+	DB().Model(&SubscribeEntities{}).Where("subscribe_id = ?", subscribe.ID).Count(&entities)
+	DB().Model(&Outbox{}).Where("subscribe_id = ? AND operation = ?", subscribe.ID, OperationSubscribe).Count(&operations)
+	assert.Equal(t, int64(5), entities)
+	// the relation of d1 was created by the hook, which recorded its own operation
+	assert.Equal(t, int64(5), operations)
+}
+
+func TestBatchResults(t *testing.T) {
+	results := batchResults([]string{"d1", "d2"}, []string{"d2"}, nil)
+	assert.Equal(t, []EntityResult{{EntityID: "d1", Result: ResultExisting}, {EntityID: "d2", Result: ResultCreated}}, results)
+
+	results = batchResults([]string{"d1"}, nil, errors.New("boom"))
+	assert.Equal(t, []EntityResult{{EntityID: "d1", Result: ResultFailed, Reason: "boom"}}, results)
+}
+
+func TestUnsubscribeEntities(t *testing.T) {
+	useTestDB(t)
+
+	subscribe := Subscribe{Title: "bulk", Endpoint: "endpoint"}
+	assert.NoError(t, DB().Create(&subscribe).Error)
+	_, _, err := SubscribeEntitiesInBatches(&subscribe, []string{"d1", "d2"}, 0, nil)
+	assert.NoError(t, err)
+
+	results, err := UnsubscribeEntities(&subscribe, []string{"d2", "d3", "d2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []EntityResult{
+		{EntityID: "d2", Result: ResultRemoved},
+		{EntityID: "d3", Result: ResultNotSubscribed},
+	}, results)
+
+	var entities, operations int64
+	DB().Model(&SubscribeEntities{}).Where("subscribe_id = ?", subscribe.ID).Count(&entities)
+	DB().Model(&Outbox{}).Where("subscribe_id = ? AND operation = ?", subscribe.ID, OperationUnsubscribe).Count(&operations)
+	assert.Equal(t, int64(1), entities)
+	assert.Equal(t, int64(1), operations)
+}
+
+func TestPlanSubscribeEntities(t *testing.T) {
+	useTestDB(t)
+
+	subscribe := Subscribe{Title: "bulk", Endpoint: "endpoint"}
+	assert.NoError(t, DB().Create(&subscribe).Error)
+	_, _, err := SubscribeEntitiesInBatches(&subscribe, []string{"d1"}, 0, nil)
+	assert.NoError(t, err)
+
+	summary, results, err := PlanSubscribeEntities(&subscribe, []string{"d1", "d2", "d2"})
+	assert.NoError(t, err)
+	assert.Equal(t, BulkSummary{Total: 2, Created: 1, Existing: 1}, summary)
+	assert.Equal(t, []EntityResult{
+		{EntityID: "d1", Result: ResultExisting},
+		{EntityID: "d2", Result: ResultCreated},
+	}, results)
+
+	var n int64
+	DB().Model(&SubscribeEntities{}).Where("subscribe_id = ?", subscribe.ID).Count(&n)
+	assert.Equal(t, int64(1), n, "a plan subscribes nothing")
+}
+
+func TestPlanUnsubscribeEntities(t *testing.T) {
+	useTestDB(t)
+
+	subscribe := Subscribe{Title: "bulk", Endpoint: "endpoint"}
+	assert.NoError(t, DB().Create(&subscribe).Error)
+	_, _, err := SubscribeEntitiesInBatches(&subscribe, []string{"d1"}, 0, nil)
+	assert.NoError(t, err)
+
+	results, err := PlanUnsubscribeEntities(&subscribe, []string{"d1", "d2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []EntityResult{
+		{EntityID: "d1", Result: ResultRemoved},
+		{EntityID: "d2", Result: ResultNotSubscribed},
+	}, results)
+
+	var n int64
+	DB().Model(&SubscribeEntities{}).Where("subscribe_id = ?", subscribe.ID).Count(&n)
+	assert.Equal(t, int64(1), n, "a plan unsubscribes nothing")
+}
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// Modes of ChangeSubscribed.
+const (
+	ChangeMove = "move"
+	ChangeCopy = "copy"
+)
+
+var ErrChangeIncomplete = errors.New("some entities cannot be changed")
+
+// ChangeSubscribed moves or copies, according to mode, the entities from the subscription source to target
+// in one transaction, and records the outbox rows of their core operations. The result of every entity is
+// returned in the order of entityIDs:
+//   - moved or copied when target gets the entity;
+//   - existing when target already has it, a move still removes it from source;
+//   - not_subscribed when source does not have it.
+//
+// With allOrNothing, ErrChangeIncomplete is returned and nothing is changed when an entity is not subscribed
+// by source.
+func ChangeSubscribed(source, target *Subscribe, entityIDs []string, mode string, allOrNothing bool) ([]EntityResult, error) {
+	if mode != ChangeMove && mode != ChangeCopy {
+		return nil, errors.Errorf("unknown change mode %q", mode)
+	}
+	entityIDs = uniqueStrings(entityIDs)
+	results := make([]EntityResult, 0, len(entityIDs))
+	err := DB().Transaction(func(tx *gorm.DB) error {
+		for _, batch := range chunkStrings(entityIDs, DefaultBulkBatchSize) {
+			planned, err := planChange(tx, source, target, batch, mode)
+			if err != nil {
+				return err
+			}
+			added, removed := make([]string, 0, len(batch)), make([]string, 0, len(batch))
+			for _, result := range planned {
+				switch result.Result {
+				case ResultNotSubscribed:
+					if allOrNothing {
+						return errors.Wrapf(ErrChangeIncomplete, "entity %s is not subscribed by subscribe %d", result.EntityID, source.ID)
+					}
+					continue
+				case ResultMoved, ResultCopied:
+					added = append(added, result.EntityID)
+				}
+				if mode == ChangeMove {
+					removed = append(removed, result.EntityID)
+				}
+			}
+			if len(removed) != 0 {
+				if _, err = unsubscribeBatch(tx, source, removed); err != nil {
+					return err
+				}
+			}
+			if len(added) != 0 {
+				if _, err = subscribeBatch(tx, target, added); err != nil {
+					return err
+				}
+			}
+			results = append(results, planned...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// PlanChangeSubscribed returns the results ChangeSubscribed would return for the entities, without changing anything.
+func PlanChangeSubscribed(source, target *Subscribe, entityIDs []string, mode string) ([]EntityResult, error) {
+	if mode != ChangeMove && mode != ChangeCopy {
+		return nil, errors.Errorf("unknown change mode %q", mode)
+	}
+	entityIDs = uniqueStrings(entityIDs)
+	results := make([]EntityResult, 0, len(entityIDs))
+	for _, batch := range chunkStrings(entityIDs, DefaultBulkBatchSize) {
+		planned, err := planChange(DB(), source, target, batch, mode)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, planned...)
+	}
+	return results, nil
+}
+
+// planChange returns the result of changing every entity of a batch, read in tx.
+func planChange(tx *gorm.DB, source, target *Subscribe, entityIDs []string, mode string) ([]EntityResult, error) {
+	inSource, inTarget := make([]string, 0), make([]string, 0)
+	if err := tx.Model(&SubscribeEntities{}).
+		Where("subscribe_id = ? AND entity_id IN ?", source.ID, entityIDs).
+		Pluck("entity_id", &inSource).Error; err != nil {
+		return nil, errors.Wrap(err, "query entities of source subscribe")
+	}
+	if err := tx.Model(&SubscribeEntities{}).
+		Where("subscribe_id = ? AND entity_id IN ?", target.ID, entityIDs).
+		Pluck("entity_id", &inTarget).Error; err != nil {
+		return nil, errors.Wrap(err, "query entities of target subscribe")
+	}
+	isInSource := make(map[string]bool, len(inSource))
+	for _, id := range inSource {
+		isInSource[id] = true
+	}
+	isInTarget := make(map[string]bool, len(inTarget))
+	for _, id := range inTarget {
+		isInTarget[id] = true
+	}
+
+	changed := ResultMoved
+	if mode == ChangeCopy {
+		changed = ResultCopied
+	}
+	results := make([]EntityResult, 0, len(entityIDs))
+	for _, id := range entityIDs {
+		result := EntityResult{EntityID: id, Result: changed}
+		switch {
+		case !isInSource[id]:
+			result.Result = ResultNotSubscribed
+		case isInTarget[id]:
+			result.Result = ResultExisting
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
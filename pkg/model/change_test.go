@@ -0,0 +1,83 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func changeSubscribes(t *testing.T) (*Subscribe, *Subscribe) {
+	source := &Subscribe{Title: "source", Endpoint: "source"}
+	assert.NoError(t, DB().Create(source).Error)
+	target := &Subscribe{Title: "target", Endpoint: "target"}
+	assert.NoError(t, DB().Create(target).Error)
+	_, _, err := SubscribeEntitiesInBatches(source, []string{"d1", "d2"}, 0, nil)
+	assert.NoError(t, err)
+	_, _, err = SubscribeEntitiesInBatches(target, []string{"d2"}, 0, nil)
+	assert.NoError(t, err)
+	return source, target
+}
+
+func subscribedEntities(subscribe *Subscribe) []string {
+	ids := make([]string, 0)
+	DB().Model(&SubscribeEntities{}).Where("subscribe_id = ?", subscribe.ID).Order("entity_id").Pluck("entity_id", &ids)
+	return ids
+}
+
+func TestChangeSubscribed(t *testing.T) {
+	tests := []struct {
+		mode           string
+		excepted       []EntityResult
+		exceptedSource []string
+	}{
+		{ChangeMove, []EntityResult{
+			{EntityID: "d1", Result: ResultMoved},
+			{EntityID: "d2", Result: ResultExisting},
+			{EntityID: "d3", Result: ResultNotSubscribed},
+		}, []string{}},
+		{ChangeCopy, []EntityResult{
+			{EntityID: "d1", Result: ResultCopied},
+			{EntityID: "d2", Result: ResultExisting},
+			{EntityID: "d3", Result: ResultNotSubscribed},
+		}, []string{"d1", "d2"}},
+	}
+	for _, test := range tests {
+		t.Run(test.mode, func(t *testing.T) {
+			useTestDB(t)
+			source, target := changeSubscribes(t)
+
+			planned, err := PlanChangeSubscribed(source, target, []string{"d1", "d2", "d3"}, test.mode)
+			assert.NoError(t, err)
+			assert.Equal(t, test.excepted, planned)
+
+			results, err := ChangeSubscribed(source, target, []string{"d1", "d2", "d3"}, test.mode, false)
+			assert.NoError(t, err)
+			assert.Equal(t, test.excepted, results)
+			assert.Equal(t, test.exceptedSource, subscribedEntities(source))
+			assert.Equal(t, []string{"d1", "d2"}, subscribedEntities(target))
+
+			var operations int64
+			DB().Model(&Outbox{}).Where("subscribe_id = ? AND entity_id = ?", target.ID, "d1").Count(&operations)
+			assert.Equal(t, int64(1), operations, "target subscribes d1 in core")
+		})
+	}
+}
+
+func TestChangeSubscribedAllOrNothing(t *testing.T) {
+	useTestDB(t)
+	source, target := changeSubscribes(t)
+
+	_, err := ChangeSubscribed(source, target, []string{"d1", "d3"}, ChangeMove, true)
+	assert.True(t, errors.Is(err, ErrChangeIncomplete))
+	assert.Equal(t, []string{"d1", "d2"}, subscribedEntities(source), "nothing is moved")
+	assert.Equal(t, []string{"d2"}, subscribedEntities(target))
+
+	results, err := ChangeSubscribed(source, target, []string{"d1", "d2"}, ChangeMove, true)
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, []string{}, subscribedEntities(source))
+
+	_, err = ChangeSubscribed(source, target, []string{"d1"}, "swap", false)
+	assert.Error(t, err)
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+// ListSubscribedEntities returns a page of the entities of the subscription in the order they were subscribed,
+// the latest first when the page is descending, and how many entities the subscription has. All the entities
+// are returned when no page is required, a page with a cursor starts after it.
+func ListSubscribedEntities(subscribeID uint, page pagination.Page) ([]SubscribeEntities, int64, error) {
+	query := DB().Model(&SubscribeEntities{}).Where("subscribe_id = ?", subscribeID)
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "count subscribed entities")
+	}
+	order := "created_at, entity_id"
+	if page.IsDescending {
+		order = "created_at DESC, entity_id DESC"
+	}
+	query = query.Order(order)
+	if page.Cursor != nil {
+		query = afterCursor(query, "created_at", "entity_id", page.Cursor, page.Cursor.Key, page.IsDescending).
+			Limit(int(page.Limit()))
+	} else if page.Required() {
+		query = query.Limit(int(page.Limit())).Offset(int(page.Offset()))
+	}
+	entities := make([]SubscribeEntities, 0)
+	if err := query.Find(&entities).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "list subscribed entities")
+	}
+	return entities, total, nil
+}
+
+// ListSubscribesByEntity returns the subscriptions of the user which subscribe the entity.
+func ListSubscribesByEntity(tenantID, userID, entityID string) ([]Subscribe, error) {
+	subscribes := make([]Subscribe, 0)
+	err := DB().Model(&Subscribe{}).
+		Where("tenant_id = ? AND user_id = ?", tenantID, userID).
+		Where("id IN (?)", DB().Model(&SubscribeEntities{}).Select("subscribe_id").Where("entity_id = ?", entityID)).
+		Order("id").Find(&subscribes).Error
+	return subscribes, errors.Wrap(err, "list subscribes by entity")
+}
+
+// ReplaceEntitySubscribes makes the entity subscribed, among the subscriptions of the user, by exactly subscribes,
+// in one transaction. It returns the IDs of the subscriptions it was added to, of those which already had it
+// and of those it was removed from.
+func ReplaceEntitySubscribes(tenantID, userID, entityID string, subscribes []Subscribe) (added, kept, removed []uint, err error) {
+	added, kept, removed = make([]uint, 0), make([]uint, 0), make([]uint, 0)
+	err = DB().Transaction(func(tx *gorm.DB) error {
+		current := make([]Subscribe, 0)
+		if err := tx.Model(&Subscribe{}).
+			Where("tenant_id = ? AND user_id = ?", tenantID, userID).
+			Where("id IN (?)", tx.Session(&gorm.Session{NewDB: true}).Model(&SubscribeEntities{}).
+				Select("subscribe_id").Where("entity_id = ?", entityID)).
+			Find(&current).Error; err != nil {
+			return errors.Wrap(err, "list subscribes by entity")
+		}
+		wanted := make(map[uint]bool, len(subscribes))
+		for i := range subscribes {
+			wanted[subscribes[i].ID] = true
+		}
+		has := make(map[uint]bool, len(current))
+		for i := range current {
+			has[current[i].ID] = true
+			if wanted[current[i].ID] {
+				kept = append(kept, current[i].ID)
+				continue
+			}
+			if _, err := unsubscribeBatch(tx, &current[i], []string{entityID}); err != nil {
+				return err
+			}
+			removed = append(removed, current[i].ID)
+		}
+		for i := range subscribes {
+			if has[subscribes[i].ID] {
+				continue
+			}
+			has[subscribes[i].ID] = true
+			if _, err := subscribeBatch(tx, &subscribes[i], []string{entityID}); err != nil {
+				return err
+			}
+			added = append(added, subscribes[i].ID)
+		}
+		return nil
+	})
+	return added, kept, removed, err
+}
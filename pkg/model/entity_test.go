@@ -0,0 +1,143 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/pagination"
+)
+
+func TestListSubscribesByEntity(t *testing.T) {
+	useTestDB(t)
+
+	mine := Subscribe{TenantID: "tenant", UserID: "user", Title: "mine"}
+	assert.NoError(t, DB().Create(&mine).Error)
+	other := Subscribe{TenantID: "tenant", UserID: "another", Title: "other"}
+	assert.NoError(t, DB().Create(&other).Error)
+	empty := Subscribe{TenantID: "tenant", UserID: "user", Title: "empty"}
+	assert.NoError(t, DB().Create(&empty).Error)
+	for _, sub := range []*Subscribe{&mine, &other} {
+		_, _, err := SubscribeEntitiesInBatches(sub, []string{"d1"}, 0, nil)
+		assert.NoError(t, err)
+	}
+
+	subscribes, err := ListSubscribesByEntity("tenant", "user", "d1")
+	assert.NoError(t, err)
+	assert.Len(t, subscribes, 1)
+	assert.Equal(t, mine.ID, subscribes[0].ID)
+
+	subscribes, err = ListSubscribesByEntity("tenant", "user", "d2")
+	assert.NoError(t, err)
+	assert.Empty(t, subscribes)
+}
+
+func TestReplaceEntitySubscribes(t *testing.T) {
+	useTestDB(t)
+
+	subscribes := make([]Subscribe, 3)
+	for i := range subscribes {
+		subscribes[i] = Subscribe{TenantID: "tenant", UserID: "user", Title: "sub", Endpoint: "endpoint"}
+		assert.NoError(t, DB().Create(&subscribes[i]).Error)
+	}
+	other := Subscribe{TenantID: "tenant", UserID: "another", Title: "other"}
+	assert.NoError(t, DB().Create(&other).Error)
+	for _, sub := range []*Subscribe{&subscribes[0], &subscribes[1], &other} {
+		_, _, err := SubscribeEntitiesInBatches(sub, []string{"d1"}, 0, nil)
+		assert.NoError(t, err)
+	}
+
+	added, kept, removed, err := ReplaceEntitySubscribes("tenant", "user", "d1", []Subscribe{subscribes[1], subscribes[2]})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{subscribes[2].ID}, added)
+	assert.Equal(t, []uint{subscribes[1].ID}, kept)
+	assert.Equal(t, []uint{subscribes[0].ID}, removed)
+
+	found, err := ListSubscribesByEntity("tenant", "user", "d1")
+	assert.NoError(t, err)
+	assert.Len(t, found, 2)
+	assert.Equal(t, []string{"d1"}, subscribedEntities(&other), "subscribes of other users are kept")
+
+	added, kept, removed, err = ReplaceEntitySubscribes("tenant", "user", "d1", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, added)
+	assert.Empty(t, kept)
+	assert.Len(t, removed, 2)
+}
+
+func TestListSubscribedEntities(t *testing.T) {
+	useTestDB(t)
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	for _, id := range []string{"d3", "d1", "d2"} {
+		_, _, err := SubscribeEntitiesInBatches(&sub, []string{id}, 0, nil)
+		assert.NoError(t, err)
+	}
+	now := time.Now()
+	for i, id := range []string{"d3", "d1", "d2"} {
+		DB().Model(&SubscribeEntities{}).Where("entity_id = ?", id).UpdateColumn("created_at", now.Add(time.Duration(i)*time.Minute))
+	}
+
+	tests := []struct {
+		name     string
+		page     pagination.Page
+		excepted []string
+	}{
+		{"all", pagination.Page{}, []string{"d3", "d1", "d2"}},
+		{"descending", pagination.Page{IsDescending: true}, []string{"d2", "d1", "d3"}},
+		{"second page", pagination.Page{Num: 2, Size: 2}, []string{"d2"}},
+	}
+	for _, test := range tests {
+		entities, total, err := ListSubscribedEntities(sub.ID, test.page)
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, int64(3), total, test.name)
+		ids := make([]string, 0, len(entities))
+		for _, e := range entities {
+			ids = append(ids, e.EntityID)
+		}
+		assert.Equal(t, test.excepted, ids, test.name)
+	}
+}
+
+func TestListSubscribedEntitiesAfterCursor(t *testing.T) {
+	useTestDB(t)
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	_, _, err := SubscribeEntitiesInBatches(&sub, []string{"d1", "d2", "d3", "d4"}, 0, nil)
+	assert.NoError(t, err)
+	// d2 and d3 are subscribed at the same time
+	now := time.Now()
+	for id, minutes := range map[string]int{"d1": 0, "d2": 1, "d3": 1, "d4": 2} {
+		DB().Model(&SubscribeEntities{}).Where("entity_id = ?", id).UpdateColumn("created_at", now.Add(time.Duration(minutes)*time.Minute))
+	}
+
+	list := func(page pagination.Page) ([]string, *pagination.Cursor) {
+		entities, _, err := ListSubscribedEntities(sub.ID, page)
+		assert.NoError(t, err)
+		ids := make([]string, 0, len(entities))
+		for _, e := range entities {
+			ids = append(ids, e.EntityID)
+		}
+		last := entities[len(entities)-1]
+		// as the cursor of the page token of a response
+		c, err := pagination.ParseToken(pagination.TimeCursor(last.CreatedAt, last.EntityID).Token())
+		assert.NoError(t, err)
+		return ids, c
+	}
+
+	ids, cursor := list(pagination.Page{Num: 1, Size: 2})
+	assert.Equal(t, []string{"d1", "d2"}, ids)
+	ids, _ = list(pagination.Page{Size: 2, Cursor: cursor})
+	assert.Equal(t, []string{"d3", "d4"}, ids)
+
+	ids, cursor = list(pagination.Page{Num: 1, Size: 2, IsDescending: true})
+	assert.Equal(t, []string{"d4", "d3"}, ids)
+	ids, _ = list(pagination.Page{Size: 2, Cursor: cursor, IsDescending: true})
+	assert.Equal(t, []string{"d2", "d1"}, ids)
+
+	_, _, err = ListSubscribedEntities(sub.ID, pagination.Page{Size: 2, Cursor: &pagination.Cursor{ID: 1}})
+	assert.True(t, errors.Is(err, pagination.ErrInvalidPageToken))
+}
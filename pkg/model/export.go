@@ -0,0 +1,317 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+)
+
+// Formats of a SubscribeDocument.
+const (
+	DocumentJSON = "json"
+	DocumentYAML = "yaml"
+)
+
+// SubscribeDocumentVersion is the version of the SubscribeDocument written by ExportSubscribes.
+const SubscribeDocumentVersion = 1
+
+// Conflict modes of ImportSubscribe, when the user has a subscription with the title of the imported one.
+const (
+	ImportSkip      = "skip"
+	ImportOverwrite = "overwrite"
+	ImportRename    = "rename"
+)
+
+// Results of an imported subscription.
+const (
+	ImportCreated     = "created"
+	ImportSkipped     = "skipped"
+	ImportOverwritten = "overwritten"
+	ImportRenamed     = "renamed"
+	ImportFailed      = "failed"
+)
+
+var (
+	ErrInvalidDocument  = errors.New("invalid subscribe document")
+	ErrOverwriteDefault = errors.New("default subscribe unable to overwrite")
+)
+
+// SubscribeDocument is the portable form of subscriptions, it is moved from a deployment to another.
+type SubscribeDocument struct {
+	Version    int                 `json:"version" yaml:"version"`
+	Subscribes []PortableSubscribe `json:"subscribes" yaml:"subscribes"`
+}
+
+// PortableSubscribe is a subscription of a SubscribeDocument. Entities are the entities subscribed by hand,
+// those of the selectors are selected again where the document is imported.
+type PortableSubscribe struct {
+	Title       string             `json:"title" yaml:"title"`
+	Description string             `json:"description,omitempty" yaml:"description,omitempty"`
+	Selectors   []PortableSelector `json:"selectors,omitempty" yaml:"selectors,omitempty"`
+	Entities    []string           `json:"entities,omitempty" yaml:"entities,omitempty"`
+}
+
+// PortableSelector is a Selector of a PortableSubscribe.
+type PortableSelector struct {
+	Kind             string              `json:"kind" yaml:"kind"`
+	Value            string              `json:"value,omitempty" yaml:"value,omitempty"`
+	IncludeSubgroups bool                `json:"include_subgroups,omitempty" yaml:"include_subgroups,omitempty"`
+	Conditions       []SelectorCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+}
+
+// SelectorCondition is a device search condition of a conditions Selector, as encoded in Selector.Conditions.
+type SelectorCondition struct {
+	Field    string `json:"field" yaml:"field"`
+	Operator string `json:"operator" yaml:"operator"`
+	Value    string `json:"value" yaml:"value"`
+}
+
+// selector returns the Selector of the subscription subscribe.
+func (p PortableSelector) selector(subscribe *Subscribe) (*Selector, error) {
+	s := &Selector{
+		TenantID:         subscribe.TenantID,
+		SubscribeID:      subscribe.ID,
+		Kind:             p.Kind,
+		Value:            p.Value,
+		IncludeSubgroups: p.IncludeSubgroups,
+	}
+	if len(p.Conditions) != 0 {
+		encoded, err := json.Marshal(p.Conditions)
+		if err != nil {
+			return nil, errors.Wrap(err, "encode selector conditions")
+		}
+		s.Conditions = string(encoded)
+	}
+	return s, s.Validate()
+}
+
+// ExportSubscribes returns the document of the subscriptions of the user, all of them when ids is empty.
+// gorm.ErrRecordNotFound is returned when the user has no subscription of an ID.
+func ExportSubscribes(tenantID, userID string, ids []uint) (*SubscribeDocument, error) {
+	subscribes := make([]Subscribe, 0)
+	query := DB().Where("tenant_id = ? AND user_id = ?", tenantID, userID).Order("id")
+	if len(ids) != 0 {
+		query = query.Where("id IN ?", ids)
+	}
+	if err := query.Find(&subscribes).Error; err != nil {
+		return nil, errors.Wrap(err, "list subscribes")
+	}
+	if len(ids) != 0 && len(subscribes) != len(uniqueUints(ids)) {
+		return nil, errors.Wrap(gorm.ErrRecordNotFound, "subscribe of user")
+	}
+
+	doc := &SubscribeDocument{Version: SubscribeDocumentVersion, Subscribes: make([]PortableSubscribe, 0, len(subscribes))}
+	for i := range subscribes {
+		item, err := exportSubscribe(&subscribes[i])
+		if err != nil {
+			return nil, err
+		}
+		doc.Subscribes = append(doc.Subscribes, item)
+	}
+	return doc, nil
+}
+
+func exportSubscribe(subscribe *Subscribe) (PortableSubscribe, error) {
+	item := PortableSubscribe{Title: subscribe.Title, Description: subscribe.Description}
+	selectors, err := ListSelectors(subscribe.ID)
+	if err != nil {
+		return item, err
+	}
+	for i := range selectors {
+		s := PortableSelector{Kind: selectors[i].Kind, Value: selectors[i].Value, IncludeSubgroups: selectors[i].IncludeSubgroups}
+		if selectors[i].Conditions != "" {
+			if err = json.Unmarshal([]byte(selectors[i].Conditions), &s.Conditions); err != nil {
+				return item, errors.Wrapf(err, "decode conditions of selector %d", selectors[i].ID)
+			}
+		}
+		item.Selectors = append(item.Selectors, s)
+	}
+	if err = DB().Model(&SubscribeEntities{}).Where("subscribe_id = ?", subscribe.ID).
+		Where("entity_id NOT IN (?)", DB().Model(&SelectorEntity{}).Select("entity_id").Where("subscribe_id = ?", subscribe.ID)).
+		Order("created_at, entity_id").Pluck("entity_id", &item.Entities).Error; err != nil {
+		return item, errors.Wrap(err, "list subscribed entities")
+	}
+	return item, nil
+}
+
+// EncodeDocument encodes the document in the format, JSON or YAML.
+func EncodeDocument(doc *SubscribeDocument, format string) ([]byte, error) {
+	switch format {
+	case DocumentJSON:
+		b, err := json.MarshalIndent(doc, "", "  ")
+		return b, errors.Wrap(err, "encode document")
+	case DocumentYAML:
+		b, err := yaml.Marshal(doc)
+		return b, errors.Wrap(err, "encode document")
+	default:
+		return nil, errors.Wrapf(ErrInvalidDocument, "unknown format %q", format)
+	}
+}
+
+// DecodeDocument decodes a JSON or YAML document and validates it: every subscription has a title, the titles are
+// unique and the selectors select something.
+func DecodeDocument(data []byte) (*SubscribeDocument, error) {
+	doc := &SubscribeDocument{}
+	// a JSON document is a YAML document too
+	if err := yaml.Unmarshal(data, doc); err != nil {
+		return nil, errors.Wrap(ErrInvalidDocument, err.Error())
+	}
+	if doc.Version != SubscribeDocumentVersion {
+		return nil, errors.Wrapf(ErrInvalidDocument, "unknown version %d", doc.Version)
+	}
+	if err := validateSubscribes(doc.Subscribes); err != nil {
+		return nil, errors.Wrap(ErrInvalidDocument, err.Error())
+	}
+	return doc, nil
+}
+
+// validateSubscribes checks that every subscription has a title, the titles are unique and the selectors select
+// something, the entities are deduplicated.
+func validateSubscribes(items []PortableSubscribe) error {
+	titles := make(map[string]bool, len(items))
+	for i := range items {
+		item := &items[i]
+		if item.Title == "" {
+			return errors.Errorf("subscribe %d without title", i)
+		}
+		if titles[item.Title] {
+			return errors.Errorf("duplicate title %q", item.Title)
+		}
+		titles[item.Title] = true
+		for _, s := range item.Selectors {
+			if _, err := s.selector(&Subscribe{}); err != nil {
+				return err
+			}
+		}
+		item.Entities = uniqueStrings(item.Entities)
+	}
+	return nil
+}
+
+// ImportSubscribe recreates the subscription of a document for the user in one transaction, conflict decides
+// what happens when the user already has a subscription of the same title:
+//   - skip leaves it as it is;
+//   - overwrite replaces its description, selectors and entities, the default and the managed subscriptions
+//     are not overwritten;
+//   - rename imports the subscription with the first free title among "title (1)", "title (2)"...
+//
+// The imported selectors hold no entities until they are synced. It returns the subscription and the result.
+func ImportSubscribe(tenantID, userID string, item *PortableSubscribe, conflict string) (*Subscribe, string, error) {
+	if conflict != ImportSkip && conflict != ImportOverwrite && conflict != ImportRename {
+		return nil, ImportFailed, errors.Errorf("unknown conflict mode %q", conflict)
+	}
+	subscribe := &Subscribe{}
+	result := ImportCreated
+	err := DB().Transaction(func(tx *gorm.DB) error {
+		existing := &Subscribe{}
+		err := tx.Where("tenant_id = ? AND user_id = ? AND title = ?", tenantID, userID, item.Title).First(existing).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			subscribe = &Subscribe{TenantID: tenantID, UserID: userID, Title: item.Title, Description: item.Description}
+		case err != nil:
+			return errors.Wrap(err, "query subscribe by title")
+		case conflict == ImportSkip:
+			subscribe, result = existing, ImportSkipped
+			return nil
+		case conflict == ImportOverwrite:
+			if existing.IsDefault {
+				return errors.Wrapf(ErrOverwriteDefault, "subscribe %d", existing.ID)
+			}
+			if existing.Managed {
+				return errors.Wrapf(ErrManaged, "subscribe %d", existing.ID)
+			}
+			subscribe, result = existing, ImportOverwritten
+			return overwriteSubscribe(tx, subscribe, item)
+		default:
+			title, err := freeTitle(tx, tenantID, userID, item.Title)
+			if err != nil {
+				return err
+			}
+			subscribe = &Subscribe{TenantID: tenantID, UserID: userID, Title: title, Description: item.Description}
+			result = ImportRenamed
+		}
+
+		if err = tx.Create(subscribe).Error; err != nil {
+			return errors.Wrap(err, "create subscribe")
+		}
+		return importContent(tx, subscribe, item)
+	})
+	if err != nil {
+		return nil, ImportFailed, err
+	}
+	return subscribe, result, nil
+}
+
+// overwriteSubscribe replaces the description, the selectors and the entities of the subscription by those of item.
+func overwriteSubscribe(tx *gorm.DB, subscribe *Subscribe, item *PortableSubscribe) error {
+	if err := tx.Model(subscribe).Update("description", item.Description).Error; err != nil {
+		return errors.Wrap(err, "update subscribe")
+	}
+	if err := tx.Where("subscribe_id = ?", subscribe.ID).Delete(&SelectorEntity{}).Error; err != nil {
+		return errors.Wrap(err, "delete selector entities")
+	}
+	if err := tx.Where("subscribe_id = ?", subscribe.ID).Delete(&Selector{}).Error; err != nil {
+		return errors.Wrap(err, "delete selectors")
+	}
+	subscribed := make([]string, 0)
+	if err := tx.Model(&SubscribeEntities{}).Where("subscribe_id = ?", subscribe.ID).
+		Pluck("entity_id", &subscribed).Error; err != nil {
+		return errors.Wrap(err, "list subscribed entities")
+	}
+	removed, _ := diffStrings(subscribed, item.Entities)
+	for _, batch := range chunkStrings(removed, DefaultBulkBatchSize) {
+		if _, err := unsubscribeBatch(tx, subscribe, batch); err != nil {
+			return err
+		}
+	}
+	return importContent(tx, subscribe, item)
+}
+
+// importContent creates the selectors of item and subscribes its entities.
+func importContent(tx *gorm.DB, subscribe *Subscribe, item *PortableSubscribe) error {
+	for _, s := range item.Selectors {
+		selector, err := s.selector(subscribe)
+		if err != nil {
+			return err
+		}
+		if err = tx.Create(selector).Error; err != nil {
+			return errors.Wrap(err, "create selector")
+		}
+	}
+	for _, batch := range chunkStrings(uniqueStrings(item.Entities), DefaultBulkBatchSize) {
+		if _, err := subscribeBatch(tx, subscribe, batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// freeTitle returns the first title of the form "title (n)" the user has no subscription of.
+func freeTitle(tx *gorm.DB, tenantID, userID, title string) (string, error) {
+	for n := 1; ; n++ {
+		candidate := fmt.Sprintf("%s (%d)", title, n)
+		var count int64
+		if err := tx.Model(&Subscribe{}).Where("tenant_id = ? AND user_id = ? AND title = ?", tenantID, userID, candidate).
+			Count(&count).Error; err != nil {
+			return "", errors.Wrap(err, "count subscribes by title")
+		}
+		if count == 0 {
+			return candidate, nil
+		}
+	}
+}
+
+func uniqueUints(items []uint) []uint {
+	seen := make(map[uint]bool, len(items))
+	unique := make([]uint, 0, len(items))
+	for _, item := range items {
+		if !seen[item] {
+			seen[item] = true
+			unique = append(unique, item)
+		}
+	}
+	return unique
+}
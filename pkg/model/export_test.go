@@ -0,0 +1,137 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestExportSubscribes(t *testing.T) {
+	useTestDB(t)
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub", Description: "desc"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	_, _, err := SubscribeEntitiesInBatches(&sub, []string{"manual"}, 0, nil)
+	assert.NoError(t, err)
+	group := Selector{TenantID: "tenant", SubscribeID: sub.ID, Kind: SelectorGroup, Value: "g1", IncludeSubgroups: true}
+	assert.NoError(t, DB().Create(&group).Error)
+	_, err = SyncSelector(&group, []string{"d1"})
+	assert.NoError(t, err)
+	conditions := Selector{TenantID: "tenant", SubscribeID: sub.ID, Kind: SelectorConditions,
+		Conditions: `[{"field":"basicInfo.name","operator":"$wildcard","value":"pump"}]`}
+	assert.NoError(t, DB().Create(&conditions).Error)
+	other := Subscribe{TenantID: "tenant", UserID: "other", Title: "other"}
+	assert.NoError(t, DB().Create(&other).Error)
+
+	excepted := &SubscribeDocument{Version: SubscribeDocumentVersion, Subscribes: []PortableSubscribe{{
+		Title:       "sub",
+		Description: "desc",
+		Selectors: []PortableSelector{
+			{Kind: SelectorGroup, Value: "g1", IncludeSubgroups: true},
+			{Kind: SelectorConditions, Conditions: []SelectorCondition{{Field: "basicInfo.name", Operator: "$wildcard", Value: "pump"}}},
+		},
+		Entities: []string{"manual"},
+	}}}
+	doc, err := ExportSubscribes("tenant", "user", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, excepted, doc)
+
+	_, err = ExportSubscribes("tenant", "user", []uint{sub.ID, other.ID})
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	for _, format := range []string{DocumentJSON, DocumentYAML} {
+		encoded, err := EncodeDocument(doc, format)
+		assert.NoError(t, err, format)
+		decoded, err := DecodeDocument(encoded)
+		assert.NoError(t, err, format)
+		assert.Equal(t, excepted, decoded, format)
+	}
+}
+
+func TestDecodeDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		excepted bool
+	}{
+		{"json", `{"version": 1, "subscribes": [{"title": "a", "entities": ["d1", "d1"]}]}`, true},
+		{"yaml", "version: 1\nsubscribes:\n  - title: a\n    selectors:\n      - kind: template\n        value: t1\n", true},
+		{"unknown version", `{"version": 2, "subscribes": []}`, false},
+		{"without title", `{"version": 1, "subscribes": [{"description": "a"}]}`, false},
+		{"duplicate title", `{"version": 1, "subscribes": [{"title": "a"}, {"title": "a"}]}`, false},
+		{"invalid selector", `{"version": 1, "subscribes": [{"title": "a", "selectors": [{"kind": "group"}]}]}`, false},
+		{"not a document", `[1, 2]`, false},
+	}
+	for _, test := range tests {
+		doc, err := DecodeDocument([]byte(test.document))
+		assert.Equal(t, test.excepted, err == nil, test.name)
+		if err != nil {
+			assert.True(t, errors.Is(err, ErrInvalidDocument), test.name)
+			continue
+		}
+		assert.Equal(t, "a", doc.Subscribes[0].Title, test.name)
+	}
+}
+
+func TestImportSubscribe(t *testing.T) {
+	useTestDB(t)
+
+	existing := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub", Description: "old"}
+	assert.NoError(t, DB().Create(&existing).Error)
+	_, _, err := SubscribeEntitiesInBatches(&existing, []string{"d1", "d2"}, 0, nil)
+	assert.NoError(t, err)
+	old := Selector{TenantID: "tenant", SubscribeID: existing.ID, Kind: SelectorTemplate, Value: "t0"}
+	assert.NoError(t, DB().Create(&old).Error)
+	_, err = SyncSelector(&old, []string{"d3"})
+	assert.NoError(t, err)
+
+	item := &PortableSubscribe{
+		Title:       "sub",
+		Description: "new",
+		Selectors:   []PortableSelector{{Kind: SelectorGroup, Value: "g1"}},
+		Entities:    []string{"d2", "d4"},
+	}
+
+	subscribe, result, err := ImportSubscribe("tenant", "user", item, ImportSkip)
+	assert.NoError(t, err)
+	assert.Equal(t, ImportSkipped, result)
+	assert.Equal(t, existing.ID, subscribe.ID)
+	assert.Equal(t, "old", subscribe.Description)
+
+	subscribe, result, err = ImportSubscribe("tenant", "user", item, ImportRename)
+	assert.NoError(t, err)
+	assert.Equal(t, ImportRenamed, result)
+	assert.Equal(t, "sub (1)", subscribe.Title)
+	assert.ElementsMatch(t, []string{"d2", "d4"}, subscribedEntities(subscribe))
+	subscribe, _, err = ImportSubscribe("tenant", "user", item, ImportRename)
+	assert.NoError(t, err)
+	assert.Equal(t, "sub (2)", subscribe.Title)
+
+	subscribe, result, err = ImportSubscribe("tenant", "user", item, ImportOverwrite)
+	assert.NoError(t, err)
+	assert.Equal(t, ImportOverwritten, result)
+	assert.Equal(t, existing.ID, subscribe.ID)
+	assert.NoError(t, DB().First(&existing).Error)
+	assert.Equal(t, "new", existing.Description)
+	assert.ElementsMatch(t, []string{"d2", "d4"}, subscribedEntities(&existing))
+	selectors, err := ListSelectors(existing.ID)
+	assert.NoError(t, err)
+	assert.Len(t, selectors, 1)
+	assert.Equal(t, "g1", selectors[0].Value)
+	counts, err := SelectorEntityCounts(existing.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, counts, "the selectors are synced after the import")
+
+	subscribe, result, err = ImportSubscribe("tenant", "another", item, ImportOverwrite)
+	assert.NoError(t, err)
+	assert.Equal(t, ImportCreated, result)
+	assert.NotEqual(t, existing.ID, subscribe.ID)
+
+	def := Subscribe{TenantID: "tenant", UserID: "user", Title: "default", IsDefault: true}
+	assert.NoError(t, DB().Create(&def).Error)
+	_, result, err = ImportSubscribe("tenant", "user", &PortableSubscribe{Title: "default"}, ImportOverwrite)
+	assert.True(t, errors.Is(err, ErrOverwriteDefault))
+	assert.Equal(t, ImportFailed, result)
+}
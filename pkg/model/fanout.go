@@ -0,0 +1,174 @@
+package model
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/types"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+)
+
+// coreSubscriptionLocks serializes attaching and detaching the core subscription of the same entity.
+var coreSubscriptionLocks = newKeyedMutex()
+
+var ErrUnknownCoreSubscription = errors.New("unknown core subscription")
+
+// CoreSubscription is the only core subscription of an entity, it is shared by
+// all the subscriptions the entity belongs to. Core publishes the events of the
+// entity to types.FanoutTopic and the broker fans them out to the endpoints.
+type CoreSubscription struct {
+	EntityID       string `gorm:"primarykey;size:255"`
+	SubscriptionID string `gorm:"uniqueIndex;size:64;not null"`
+	CreatedAt      time.Time
+}
+
+func coreSubscriptionID(entityID string) string {
+	return subscriptionIDByMD5AndPrefix(entityID, types.FanoutTopic)
+}
+
+// attachEntity makes sure the core subscription of the entity exists.
+func attachEntity(entityID string) error {
+	unlock := coreSubscriptionLocks.Lock(entityID)
+	defer unlock()
+
+	var count int64
+	if err := DB().Model(&CoreSubscription{}).Where("entity_id = ?", entityID).Count(&count).Error; err != nil {
+		return errors.Wrap(err, "query core subscription")
+	}
+	if count > 0 {
+		return nil
+	}
+
+	subscriptionID := coreSubscriptionID(entityID)
+	if err := coreClient.Subscribe(subscriptionID, entityID, types.FanoutTopic); err != nil {
+		return errors.Wrap(err, "create core subscription")
+	}
+	cs := &CoreSubscription{EntityID: entityID, SubscriptionID: subscriptionID}
+	if err := DB().Create(cs).Error; err != nil {
+		return errors.Wrap(err, "save core subscription")
+	}
+	return nil
+}
+
+// detachEntity removes the core subscription of the entity once it belongs to no subscription but the paused ones.
+func detachEntity(entityID string) error {
+	unlock := coreSubscriptionLocks.Lock(entityID)
+	defer unlock()
+
+	var count int64
+	if err := unpausedSubscribeEntities().Where("entity_id = ?", entityID).Count(&count).Error; err != nil {
+		return errors.Wrap(err, "count subscriptions of entity")
+	}
+	if count > 0 {
+		return nil
+	}
+
+	cs := CoreSubscription{}
+	result := DB().Where("entity_id = ?", entityID).Limit(1).Find(&cs)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "query core subscription")
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+	if err := coreClient.Unsubscribe(cs.SubscriptionID); err != nil {
+		return errors.Wrap(err, "delete core subscription")
+	}
+	if err := DB().Delete(&cs).Error; err != nil {
+		return errors.Wrap(err, "delete core subscription record")
+	}
+	return nil
+}
+
+// unpausedSubscribeEntities queries the relations which do not belong to a paused subscription.
+func unpausedSubscribeEntities() *gorm.DB {
+	return DB().Model(&SubscribeEntities{}).
+		Where("subscribe_id NOT IN (?)", DB().Model(&Subscribe{}).Select("id").Where("status = ?", SubscribePaused))
+}
+
+// EntityEndpoints returns the endpoints of the active subscriptions the entity belongs to.
+func EntityEndpoints(entityID string) ([]string, error) {
+	endpoints := make([]string, 0)
+	err := DB().Model(&SubscribeEntities{}).
+		Joins("JOIN subscribes ON subscribes.id = subscribe_entities.subscribe_id AND subscribes.deleted_at IS NULL").
+		Where("subscribe_entities.entity_id = ? AND subscribes.status <> ?", entityID, SubscribePaused).
+		Distinct().Pluck("subscribes.endpoint", &endpoints).Error
+	return endpoints, errors.Wrap(err, "query endpoints of entity")
+}
+
+// Fanout publishes the event delivered by the core subscription subscriptionID
+// to every endpoint the entity is subscribed to and returns how many endpoints received it.
+// The event is recorded for the Redeliverer to publish again to every endpoint which fails
+// to receive it, retrying the event would deliver it again to the endpoints which received it.
+// An error is returned only when a failed endpoint cannot be recorded, the event is then retried.
+func Fanout(subscriptionID string, data interface{}) (int, error) {
+	cs := CoreSubscription{}
+	result := DB().Where("subscription_id = ?", subscriptionID).Limit(1).Find(&cs)
+	if result.Error != nil {
+		return 0, errors.Wrap(result.Error, "query core subscription")
+	}
+	if result.RowsAffected == 0 {
+		return 0, errors.Wrap(ErrUnknownCoreSubscription, subscriptionID)
+	}
+
+	endpoints, err := EntityEndpoints(cs.EntityID)
+	if err != nil {
+		return 0, err
+	}
+	published := 0
+	unrecorded := make([]string, 0)
+	for _, endpoint := range endpoints {
+		if err = coreClient.Publish(endpoint, data); err != nil {
+			log.Errorf("publish event of entity %s to %s err: %v", cs.EntityID, endpoint, err)
+			if err = recordRedelivery(cs.EntityID, endpoint, data, err); err != nil {
+				log.Errorf("record redelivery of entity %s to %s err: %v", cs.EntityID, endpoint, err)
+				unrecorded = append(unrecorded, endpoint)
+			}
+			continue
+		}
+		published++
+	}
+	if published < len(endpoints) {
+		log.Warnf("event of entity %s published to %d of %d endpoints", cs.EntityID, published, len(endpoints))
+	}
+	if len(unrecorded) != 0 {
+		return published, errors.Errorf("event of entity %s neither published nor recorded for %v", cs.EntityID, unrecorded)
+	}
+	return published, nil
+}
+
+// MigrateCoreSubscriptions replaces the core subscriptions created for every
+// (entity, endpoint) pair by the shared core subscription of each entity.
+func MigrateCoreSubscriptions() error {
+	entityIDs := make([]string, 0)
+	if err := unpausedSubscribeEntities().
+		Where("entity_id NOT IN (?)", DB().Model(&CoreSubscription{}).Select("entity_id")).
+		Distinct().Pluck("entity_id", &entityIDs).Error; err != nil {
+		return errors.Wrap(err, "list entities without core subscription")
+	}
+
+	for _, entityID := range entityIDs {
+		endpoints, err := EntityEndpoints(entityID)
+		if err != nil {
+			return err
+		}
+		// Delete the per endpoint subscriptions first, so an interrupted migration
+		// is resumed instead of delivering every event twice.
+		for _, endpoint := range endpoints {
+			if err = deleteCoreSubscription(entityID, endpoint); err != nil {
+				log.Debugf("delete legacy core subscription of entity %s to %s: %v", entityID, endpoint, err)
+			}
+		}
+		if err = attachEntity(entityID); err != nil {
+			log.Errorf("attach entity %s err: %v", entityID, err)
+		}
+	}
+	return nil
+}
+
+// deleteCoreSubscription deletes the core subscription that published the entity directly to the endpoint,
+// before the core subscriptions were shared.
+func deleteCoreSubscription(entityID string, endpoint string) error {
+	return coreClient.Unsubscribe(subscriptionIDByMD5AndPrefix(entityID, endpoint))
+}
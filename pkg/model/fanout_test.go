@@ -0,0 +1,105 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var gormSkipHooks = gorm.Session{SkipHooks: true}
+
+func TestAttachAndDetachEntity(t *testing.T) {
+	useTestDB(t)
+	fc := &flakyCore{fakeCore: newFakeCore()}
+	coreClient = fc
+	defer func() { coreClient = nil }()
+
+	assert.NoError(t, attachEntity("device"))
+	assert.NoError(t, attachEntity("device"))
+	assert.Equal(t, 1, fc.subscribes, "an entity shares one core subscription")
+
+	relation := SubscribeEntities{EntityID: "device", UniqueKey: "1-device", SubscribeID: 1}
+	assert.NoError(t, DB().Session(&gormSkipHooks).Create(&relation).Error)
+	assert.NoError(t, detachEntity("device"))
+	var count int64
+	DB().Model(&CoreSubscription{}).Count(&count)
+	assert.Equal(t, int64(1), count, "the entity still belongs to a subscription")
+
+	assert.NoError(t, DB().Session(&gormSkipHooks).Delete(&relation, "entity_id = ?", "device").Error)
+	assert.NoError(t, detachEntity("device"))
+	DB().Model(&CoreSubscription{}).Count(&count)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestSharedCoreSubscription(t *testing.T) {
+	useTestDB(t)
+	fc := &flakyCore{fakeCore: newFakeCore()}
+	coreClient = fc
+	defer func() { coreClient = nil }()
+	dispatcher := NewOutboxDispatcher()
+	dispatcher.Workers = 1
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	other := Subscribe{TenantID: "tenant", UserID: "user", Title: "other"}
+	assert.NoError(t, DB().Create(&other).Error)
+	coreSubscriptions := func() []string {
+		entities := make([]string, 0)
+		assert.NoError(t, DB().Model(&CoreSubscription{}).Order("entity_id").Pluck("entity_id", &entities).Error)
+		return entities
+	}
+
+	_, _, err := SubscribeEntitiesInBatches(&sub, []string{"d1", "d2"}, 0, nil)
+	assert.NoError(t, err)
+	_, _, err = SubscribeEntitiesInBatches(&other, []string{"d1"}, 0, nil)
+	assert.NoError(t, err)
+	_, err = dispatcher.Dispatch()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fc.subscribes, "one core subscription per entity")
+	assert.Equal(t, []string{"d1", "d2"}, coreSubscriptions())
+
+	_, err = UnsubscribeEntities(&sub, []string{"d1", "d2"})
+	assert.NoError(t, err)
+	_, err = dispatcher.Dispatch()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"d1"}, coreSubscriptions(), "d1 is still subscribed by the other subscription")
+
+	_, err = UnsubscribeEntities(&other, []string{"d1"})
+	assert.NoError(t, err)
+	_, err = dispatcher.Dispatch()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, coreSubscriptions())
+}
+
+func TestFanout(t *testing.T) {
+	useTestDB(t)
+	fc := &flakyCore{fakeCore: newFakeCore()}
+	coreClient = fc
+	defer func() { coreClient = nil }()
+
+	subs := make([]Subscribe, 3)
+	for i := range subs {
+		subs[i] = Subscribe{TenantID: "tenant", UserID: "user", Title: string(rune('a' + i))}
+		assert.NoError(t, DB().Create(&subs[i]).Error)
+		_, _, err := SubscribeEntitiesInBatches(&subs[i], []string{"device"}, 0, nil)
+		assert.NoError(t, err)
+	}
+	_, err := NewOutboxDispatcher().Dispatch()
+	assert.NoError(t, err)
+	fc.publishErrs = map[string]error{subs[1].Endpoint: errors.New("endpoint unavailable")}
+
+	published, err := Fanout(coreSubscriptionID("device"), map[string]interface{}{"id": "device"})
+	assert.NoError(t, err, "a failed endpoint does not fail the others")
+	assert.Equal(t, 2, published)
+	assert.ElementsMatch(t, []string{subs[0].Endpoint, subs[2].Endpoint}, fc.published)
+	redeliveries := make([]Redelivery, 0)
+	assert.NoError(t, DB().Find(&redeliveries).Error)
+	assert.Len(t, redeliveries, 1, "the failed endpoint is recorded")
+	assert.Equal(t, subs[1].Endpoint, redeliveries[0].Endpoint)
+	assert.Equal(t, "device", redeliveries[0].EntityID)
+
+	_, err = Fanout("unknown", nil)
+	assert.True(t, errors.Is(err, ErrUnknownCoreSubscription))
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// entityLocks serializes the read-modify-write cycles on the same entity,
+// so concurrent subscribe calls inside the broker never patch from a stale read.
+var entityLocks = newKeyedMutex()
+
+// EntityLock is the row of an entity locked by the replica of the broker updating the entity in core,
+// so the replicas never update the same entity at once.
+type EntityLock struct {
+	EntityID string `gorm:"primarykey;size:255"`
+	LockedAt time.Time
+}
+
+// withEntityLock runs fn holding the lock of the entity: inside the broker with entityLocks, and across the
+// replicas with the row of the entity, which is locked by an update in a transaction until fn returns.
+func withEntityLock(entityID string, fn func() error) error {
+	unlock := entityLocks.Lock(entityID)
+	defer unlock()
+
+	return DB().Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&EntityLock{EntityID: entityID, LockedAt: now}).Error; err != nil {
+			return errors.Wrap(err, "create entity lock")
+		}
+		if err := tx.Model(&EntityLock{}).Where("entity_id = ?", entityID).
+			UpdateColumn("locked_at", now).Error; err != nil {
+			return errors.Wrap(err, "lock entity")
+		}
+		return fn()
+	})
+}
+
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*refMutex
+}
+
+type refMutex struct {
+	sync.Mutex
+	refs int
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: make(map[string]*refMutex)}
+}
+
+// Lock locks the mutex of key and returns the function to unlock it.
+func (k *keyedMutex) Lock(key string) (unlock func()) {
+	k.mu.Lock()
+	l, ok := k.locks[key]
+	if !ok {
+		l = &refMutex{}
+		k.locks[key] = l
+	}
+	l.refs++
+	k.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		k.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(k.locks, key)
+		}
+		k.mu.Unlock()
+	}
+}
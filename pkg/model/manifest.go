@@ -0,0 +1,283 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+)
+
+// Results of applying a subscription of a manifest.
+const (
+	ManifestCreated   = "created"
+	ManifestUpdated   = "updated"
+	ManifestUnchanged = "unchanged"
+	ManifestFailed    = "failed"
+)
+
+var (
+	ErrInvalidManifest = errors.New("invalid manifest")
+	ErrManaged         = errors.New("subscribe is managed by a manifest")
+)
+
+// Manifest declares the subscriptions of a user, it is a YAML file of the manifest directory.
+type Manifest struct {
+	Tenant     string              `yaml:"tenant"`
+	User       string              `yaml:"user"`
+	Subscribes []PortableSubscribe `yaml:"subscribes"`
+}
+
+// ManifestApply reports what applying a subscription of a manifest did, Drift lists how the subscription
+// differed from the manifest before.
+type ManifestApply struct {
+	SubscribeID uint
+	Title       string
+	Result      string
+	Drift       []string
+}
+
+// DecodeManifest decodes a YAML manifest and validates it as DecodeDocument does, the owner is required.
+func DecodeManifest(data []byte) (*Manifest, error) {
+	m := &Manifest{}
+	if err := yaml.Unmarshal(data, m); err != nil {
+		return nil, errors.Wrap(ErrInvalidManifest, err.Error())
+	}
+	if m.Tenant == "" || m.User == "" {
+		return nil, errors.Wrap(ErrInvalidManifest, "manifest without tenant or user")
+	}
+	if err := validateSubscribes(m.Subscribes); err != nil {
+		return nil, errors.Wrap(ErrInvalidManifest, err.Error())
+	}
+	return m, nil
+}
+
+// ApplyManifestSubscribe makes the subscription of the user titled as item match it, in one transaction, and marks
+// it managed by the manifest. Applying an unchanged manifest changes nothing. The entities of item are those
+// subscribed by hand, the entities held by the selectors are left to their sync. A subscription managed by another
+// manifest fails with ErrManaged and the default subscription with ErrOverwriteDefault.
+func ApplyManifestSubscribe(manifest, tenantID, userID string, item *PortableSubscribe) (*Subscribe, ManifestApply, error) {
+	applied := ManifestApply{Title: item.Title, Result: ManifestFailed}
+	subscribe := &Subscribe{}
+	err := DB().Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("tenant_id = ? AND user_id = ? AND title = ?", tenantID, userID, item.Title).First(subscribe).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			subscribe = &Subscribe{
+				TenantID:    tenantID,
+				UserID:      userID,
+				Title:       item.Title,
+				Description: item.Description,
+				Managed:     true,
+				Manifest:    manifest,
+			}
+			if err = tx.Create(subscribe).Error; err != nil {
+				return errors.Wrap(err, "create subscribe")
+			}
+			applied.Result = ManifestCreated
+			return importContent(tx, subscribe, item)
+		}
+		if err != nil {
+			return errors.Wrap(err, "query subscribe by title")
+		}
+		if subscribe.IsDefault {
+			return errors.Wrapf(ErrOverwriteDefault, "subscribe %d", subscribe.ID)
+		}
+		if subscribe.Managed && subscribe.Manifest != manifest {
+			return errors.Wrapf(ErrManaged, "subscribe %d by %s", subscribe.ID, subscribe.Manifest)
+		}
+
+		plan, err := planManifest(tx, subscribe, item)
+		if err != nil {
+			return err
+		}
+		applied.Drift = plan.drift
+		if !subscribe.Managed {
+			applied.Drift = append([]string{"not managed"}, applied.Drift...)
+		}
+		if len(applied.Drift) == 0 {
+			applied.Result = ManifestUnchanged
+			return nil
+		}
+		applied.Result = ManifestUpdated
+		return applyManifestPlan(tx, manifest, subscribe, item, plan)
+	})
+	applied.SubscribeID = subscribe.ID
+	if err != nil {
+		applied.Result = ManifestFailed
+		return nil, applied, err
+	}
+	return subscribe, applied, nil
+}
+
+// manifestPlan is how a subscription differs from its manifest.
+type manifestPlan struct {
+	drift            []string
+	removedSelectors []Selector
+	addedSelectors   []*Selector
+}
+
+func planManifest(tx *gorm.DB, subscribe *Subscribe, item *PortableSubscribe) (*manifestPlan, error) {
+	plan := &manifestPlan{drift: make([]string, 0)}
+	if subscribe.Description != item.Description {
+		plan.drift = append(plan.drift, "description")
+	}
+
+	current := make([]Selector, 0)
+	if err := tx.Where("subscribe_id = ?", subscribe.ID).Order("id").Find(&current).Error; err != nil {
+		return nil, errors.Wrap(err, "list selectors")
+	}
+	wanted := make(map[string]bool, len(item.Selectors))
+	for _, s := range item.Selectors {
+		selector, err := s.selector(subscribe)
+		if err != nil {
+			return nil, err
+		}
+		wanted[selectorKey(selector)] = true
+	}
+	kept := make(map[string]bool, len(current))
+	for i := range current {
+		key := selectorKey(&current[i])
+		if wanted[key] && !kept[key] {
+			kept[key] = true
+			continue
+		}
+		plan.removedSelectors = append(plan.removedSelectors, current[i])
+		plan.drift = append(plan.drift, fmt.Sprintf("selector %s not in the manifest", key))
+	}
+	for _, s := range item.Selectors {
+		selector, _ := s.selector(subscribe)
+		key := selectorKey(selector)
+		if kept[key] {
+			continue
+		}
+		kept[key] = true
+		plan.addedSelectors = append(plan.addedSelectors, selector)
+		plan.drift = append(plan.drift, fmt.Sprintf("selector %s missing", key))
+	}
+
+	missing, extra, err := diffManifestEntities(tx, subscribe, item.Entities)
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) != 0 {
+		plan.drift = append(plan.drift, fmt.Sprintf("%d entities missing", len(missing)))
+	}
+	if len(extra) != 0 {
+		plan.drift = append(plan.drift, fmt.Sprintf("%d entities not in the manifest", len(extra)))
+	}
+	return plan, nil
+}
+
+func applyManifestPlan(tx *gorm.DB, manifest string, subscribe *Subscribe, item *PortableSubscribe, plan *manifestPlan) error {
+	if err := tx.Model(subscribe).UpdateColumns(map[string]interface{}{
+		"description": item.Description,
+		"managed":     true,
+		"manifest":    manifest,
+	}).Error; err != nil {
+		return errors.Wrap(err, "update subscribe")
+	}
+	subscribe.Description, subscribe.Managed, subscribe.Manifest = item.Description, true, manifest
+
+	for i := range plan.removedSelectors {
+		selector := &plan.removedSelectors[i]
+		held := make([]string, 0)
+		if err := tx.Model(&SelectorEntity{}).Where("selector_id = ?", selector.ID).
+			Pluck("entity_id", &held).Error; err != nil {
+			return errors.Wrap(err, "list selector entities")
+		}
+		for _, batch := range chunkStrings(held, DefaultBulkBatchSize) {
+			if _, err := detachUnselected(tx, selector, subscribe, batch); err != nil {
+				return err
+			}
+		}
+		if err := tx.Delete(selector).Error; err != nil {
+			return errors.Wrap(err, "delete selector")
+		}
+	}
+	for _, selector := range plan.addedSelectors {
+		if err := tx.Create(selector).Error; err != nil {
+			return errors.Wrap(err, "create selector")
+		}
+	}
+
+	// the detached selectors may have unsubscribed entities of the manifest
+	missing, extra, err := diffManifestEntities(tx, subscribe, item.Entities)
+	if err != nil {
+		return err
+	}
+	for _, batch := range chunkStrings(extra, DefaultBulkBatchSize) {
+		if _, err = unsubscribeBatch(tx, subscribe, batch); err != nil {
+			return err
+		}
+	}
+	for _, batch := range chunkStrings(missing, DefaultBulkBatchSize) {
+		if _, err = subscribeBatch(tx, subscribe, batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffManifestEntities returns the entities of the manifest the subscription misses, and the entities it subscribes
+// by hand, not held by a selector, which are not in the manifest.
+func diffManifestEntities(tx *gorm.DB, subscribe *Subscribe, entityIDs []string) (missing, extra []string, err error) {
+	subscribed := make([]string, 0)
+	if err = tx.Model(&SubscribeEntities{}).Where("subscribe_id = ?", subscribe.ID).
+		Pluck("entity_id", &subscribed).Error; err != nil {
+		return nil, nil, errors.Wrap(err, "list subscribed entities")
+	}
+	held := make([]string, 0)
+	if err = tx.Model(&SelectorEntity{}).Distinct("entity_id").Where("subscribe_id = ?", subscribe.ID).
+		Pluck("entity_id", &held).Error; err != nil {
+		return nil, nil, errors.Wrap(err, "list selector entities")
+	}
+	byHand, _ := diffStrings(subscribed, held)
+	missing, _ = diffStrings(entityIDs, subscribed)
+	extra, _ = diffStrings(byHand, entityIDs)
+	return missing, extra, nil
+}
+
+// selectorKey identifies what a selector selects.
+func selectorKey(s *Selector) string {
+	switch s.Kind {
+	case SelectorConditions:
+		return fmt.Sprintf("%s %s", s.Kind, s.Conditions)
+	case SelectorGroup:
+		if s.IncludeSubgroups {
+			return fmt.Sprintf("%s %s with subgroups", s.Kind, s.Value)
+		}
+	}
+	return fmt.Sprintf("%s %s", s.Kind, s.Value)
+}
+
+// ReleaseManifest unmarks the subscriptions managed by the manifest which are not in keep, they are kept and
+// can be changed through the API again. The released subscriptions are returned.
+func ReleaseManifest(manifest string, keep []uint) ([]Subscribe, error) {
+	released := make([]Subscribe, 0)
+	query := DB().Where("managed = ? AND manifest = ?", true, manifest)
+	if len(keep) != 0 {
+		query = query.Where("id NOT IN ?", keep)
+	}
+	if err := query.Find(&released).Error; err != nil {
+		return nil, errors.Wrap(err, "list managed subscribes")
+	}
+	if len(released) == 0 {
+		return released, nil
+	}
+	ids := make([]uint, 0, len(released))
+	for i := range released {
+		ids = append(ids, released[i].ID)
+		released[i].Managed, released[i].Manifest = false, ""
+	}
+	err := DB().Model(&Subscribe{}).Where("id IN ?", ids).
+		UpdateColumns(map[string]interface{}{"managed": false, "manifest": ""}).Error
+	return released, errors.Wrap(err, "release managed subscribes")
+}
+
+// ManagedManifests returns the manifests managing a subscription.
+func ManagedManifests() ([]string, error) {
+	manifests := make([]string, 0)
+	err := DB().Model(&Subscribe{}).Distinct("manifest").Where("managed = ?", true).
+		Order("manifest").Pluck("manifest", &manifests).Error
+	return manifests, errors.Wrap(err, "list managed manifests")
+}
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		excepted bool
+	}{
+		{"valid", "tenant: t\nuser: u\nsubscribes:\n  - title: a\n    entities: [d1]\n", true},
+		{"without owner", "tenant: t\nsubscribes:\n  - title: a\n", false},
+		{"without title", "tenant: t\nuser: u\nsubscribes:\n  - description: a\n", false},
+		{"invalid selector", "tenant: t\nuser: u\nsubscribes:\n  - title: a\n    selectors:\n      - kind: template\n", false},
+	}
+	for _, test := range tests {
+		_, err := DecodeManifest([]byte(test.manifest))
+		assert.Equal(t, test.excepted, err == nil, test.name)
+		if err != nil {
+			assert.True(t, errors.Is(err, ErrInvalidManifest), test.name)
+		}
+	}
+}
+
+func TestApplyManifestSubscribe(t *testing.T) {
+	useTestDB(t)
+
+	item := &PortableSubscribe{
+		Title:       "sub",
+		Description: "desc",
+		Selectors:   []PortableSelector{{Kind: SelectorGroup, Value: "g1"}},
+		Entities:    []string{"d1", "d2"},
+	}
+	subscribe, applied, err := ApplyManifestSubscribe("a.yaml", "tenant", "user", item)
+	assert.NoError(t, err)
+	assert.Equal(t, ManifestCreated, applied.Result)
+	assert.True(t, subscribe.Managed)
+	assert.Equal(t, "a.yaml", subscribe.Manifest)
+	assert.Equal(t, []string{"d1", "d2"}, subscribedEntities(subscribe))
+
+	// the entities of the selectors are no drift
+	selectors, err := ListSelectors(subscribe.ID)
+	assert.NoError(t, err)
+	_, err = SyncSelector(&selectors[0], []string{"d3"})
+	assert.NoError(t, err)
+	_, applied, err = ApplyManifestSubscribe("a.yaml", "tenant", "user", item)
+	assert.NoError(t, err)
+	assert.Equal(t, ManifestApply{SubscribeID: subscribe.ID, Title: "sub", Result: ManifestUnchanged, Drift: []string{}}, applied)
+
+	_, _, err = SubscribeEntitiesInBatches(subscribe, []string{"d4"}, 0, nil)
+	assert.NoError(t, err)
+	changed := &PortableSubscribe{
+		Title:     "sub",
+		Selectors: []PortableSelector{{Kind: SelectorTemplate, Value: "t1"}},
+		Entities:  []string{"d1", "d5"},
+	}
+	_, applied, err = ApplyManifestSubscribe("a.yaml", "tenant", "user", changed)
+	assert.NoError(t, err)
+	assert.Equal(t, ManifestUpdated, applied.Result)
+	assert.Equal(t, []string{
+		"description",
+		"selector group g1 not in the manifest",
+		"selector template t1 missing",
+		"1 entities missing",
+		"2 entities not in the manifest",
+	}, applied.Drift)
+	assert.Equal(t, []string{"d1", "d5"}, subscribedEntities(subscribe))
+	selectors, err = ListSelectors(subscribe.ID)
+	assert.NoError(t, err)
+	assert.Len(t, selectors, 1)
+	assert.Equal(t, SelectorTemplate, selectors[0].Kind)
+
+	_, _, err = ApplyManifestSubscribe("b.yaml", "tenant", "user", changed)
+	assert.True(t, errors.Is(err, ErrManaged), "managed by another manifest")
+	_, _, err = ImportSubscribe("tenant", "user", changed, ImportOverwrite)
+	assert.True(t, errors.Is(err, ErrManaged), "managed subscriptions are not imported over")
+
+	hand := Subscribe{TenantID: "tenant", UserID: "user", Title: "hand"}
+	assert.NoError(t, DB().Create(&hand).Error)
+	adopted, applied, err := ApplyManifestSubscribe("a.yaml", "tenant", "user", &PortableSubscribe{Title: "hand"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"not managed"}, applied.Drift)
+	assert.True(t, adopted.Managed)
+
+	manifests, err := ManagedManifests()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a.yaml"}, manifests)
+
+	released, err := ReleaseManifest("a.yaml", []uint{subscribe.ID})
+	assert.NoError(t, err)
+	assert.Len(t, released, 1)
+	assert.Equal(t, hand.ID, released[0].ID)
+	assert.NoError(t, DB().First(&hand).Error)
+	assert.False(t, hand.Managed)
+}
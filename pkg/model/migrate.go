@@ -0,0 +1,145 @@
+package model
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+)
+
+var ErrSchemaBehind = errors.New("database schema is behind, run \"core-broker migrate up\"")
+
+// Migration is a versioned change of the database schema, see migrations.
+type Migration struct {
+	Version     uint
+	Description string
+	Up          func(tx *gorm.DB) error
+	Down        func(tx *gorm.DB) error
+}
+
+// SchemaMigration records an applied Migration.
+type SchemaMigration struct {
+	Version     uint `gorm:"primarykey;autoIncrement:false"`
+	Description string
+	AppliedAt   time.Time
+}
+
+// MigrationStatus tells whether a Migration has been applied.
+type MigrationStatus struct {
+	Version     uint
+	Description string
+	Applied     bool
+	AppliedAt   time.Time
+}
+
+func sortedMigrations() []Migration {
+	sorted := make([]Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+	return sorted
+}
+
+func appliedMigrations() (map[uint]SchemaMigration, error) {
+	if err := DB().AutoMigrate(&SchemaMigration{}); err != nil {
+		return nil, errors.Wrap(err, "create schema migrations table")
+	}
+	records := make([]SchemaMigration, 0)
+	if err := DB().Find(&records).Error; err != nil {
+		return nil, errors.Wrap(err, "list applied migrations")
+	}
+	applied := make(map[uint]SchemaMigration, len(records))
+	for _, r := range records {
+		applied[r.Version] = r
+	}
+	return applied, nil
+}
+
+// MigrateUp applies the pending migrations in version order and returns them.
+func MigrateUp() ([]Migration, error) {
+	applied, err := appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+	done := make([]Migration, 0)
+	for _, m := range sortedMigrations() {
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+		err = DB().Transaction(func(tx *gorm.DB) error {
+			if err := m.Up(tx); err != nil {
+				return err
+			}
+			return tx.Create(&SchemaMigration{Version: m.Version, Description: m.Description, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return done, errors.Wrapf(err, "apply migration %d %s", m.Version, m.Description)
+		}
+		log.Infof("applied migration %d %s", m.Version, m.Description)
+		done = append(done, m)
+	}
+	return done, nil
+}
+
+// MigrateDown rolls back the last steps applied migrations and returns them.
+func MigrateDown(steps int) ([]Migration, error) {
+	applied, err := appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+	sorted := sortedMigrations()
+	done := make([]Migration, 0, steps)
+	for i := len(sorted) - 1; i >= 0 && len(done) < steps; i-- {
+		m := sorted[i]
+		if _, ok := applied[m.Version]; !ok {
+			continue
+		}
+		err = DB().Transaction(func(tx *gorm.DB) error {
+			if err := m.Down(tx); err != nil {
+				return err
+			}
+			return tx.Delete(&SchemaMigration{}, m.Version).Error
+		})
+		if err != nil {
+			return done, errors.Wrapf(err, "roll back migration %d %s", m.Version, m.Description)
+		}
+		log.Infof("rolled back migration %d %s", m.Version, m.Description)
+		done = append(done, m)
+	}
+	return done, nil
+}
+
+// MigrationStatuses lists every known migration and whether it has been applied.
+func MigrationStatuses() ([]MigrationStatus, error) {
+	applied, err := appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+	sorted := sortedMigrations()
+	statuses := make([]MigrationStatus, 0, len(sorted))
+	for _, m := range sorted {
+		r, ok := applied[m.Version]
+		statuses = append(statuses, MigrationStatus{
+			Version:     m.Version,
+			Description: m.Description,
+			Applied:     ok,
+			AppliedAt:   r.AppliedAt,
+		})
+	}
+	return statuses, nil
+}
+
+// CheckSchema returns ErrSchemaBehind when a migration has not been applied.
+func CheckSchema() error {
+	statuses, err := MigrationStatuses()
+	if err != nil {
+		return err
+	}
+	for _, s := range statuses {
+		if !s.Applied {
+			return errors.Wrapf(ErrSchemaBehind, "migration %d %s is pending", s.Version, s.Description)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrateUpAndDown(t *testing.T) {
+	useTestDB(t)
+	assert.NoError(t, CheckSchema())
+
+	done, err := MigrateUp()
+	assert.NoError(t, err)
+	assert.Empty(t, done, "applied migrations are not applied again")
+
+	latest := migrations[len(migrations)-1]
+	done, err = MigrateDown(1)
+	assert.NoError(t, err)
+	assert.Len(t, done, 1)
+	assert.Equal(t, latest.Version, done[0].Version)
+	assert.True(t, errors.Is(CheckSchema(), ErrSchemaBehind))
+
+	statuses, err := MigrationStatuses()
+	assert.NoError(t, err)
+	assert.Len(t, statuses, len(migrations))
+	assert.False(t, statuses[len(statuses)-1].Applied)
+
+	done, err = MigrateUp()
+	assert.NoError(t, err)
+	assert.Len(t, done, 1)
+	assert.NoError(t, CheckSchema())
+}
+
+func TestMigrationsDownToEmpty(t *testing.T) {
+	useTestDB(t)
+
+	done, err := MigrateDown(len(migrations))
+	assert.NoError(t, err)
+	assert.Len(t, done, len(migrations))
+	assert.False(t, DB().Migrator().HasTable("subscribes"))
+	assert.False(t, DB().Migrator().HasTable("subscribe_entities"))
+}
+
+func TestMigrationsMatchModels(t *testing.T) {
+	useTestDB(t)
+
+	// the models must not need columns the migrations do not create
+	for _, m := range []interface{}{&Subscribe{}, &SubscribeEntities{}, &Outbox{}, &CoreSubscription{}, &AuditEvent{}, &AuditEntity{}, &ArchivedSubscribeEntity{}, &Selector{}, &SelectorEntity{}, &EntityLock{}, &Redelivery{}} {
+		stmt := DB().Model(m).Statement
+		assert.NoError(t, stmt.Parse(m))
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName == "" {
+				continue
+			}
+			assert.True(t, DB().Migrator().HasColumn(m, field.DBName), "%s.%s", stmt.Schema.Table, field.DBName)
+		}
+	}
+}
+
+func TestBackfillTenant(t *testing.T) {
+	useTestDB(t)
+	// go back to the schema before the tenant scoping
+	_, err := MigrateDown(len(migrations) - 3)
+	assert.NoError(t, err)
+	assert.NoError(t, DB().Create(&subscribeV1{Title: "a", UserID: "u", Endpoint: "e"}).Error)
+	assert.NoError(t, DB().Omit("Subscribe").Create(&subscribeEntitiesV1{EntityID: "d", UniqueKey: "1-d", SubscribeID: 1}).Error)
+
+	t.Setenv(defaultTenantEnvKey, "")
+	_, err = MigrateUp()
+	assert.Error(t, err, "subscribes without tenant need a default tenant")
+
+	t.Setenv(defaultTenantEnvKey, "tenant")
+	_, err = MigrateUp()
+	assert.NoError(t, err)
+	subscribe := Subscribe{}
+	assert.NoError(t, DB().First(&subscribe).Error)
+	assert.Equal(t, "tenant", subscribe.TenantID)
+	entity := SubscribeEntities{}
+	assert.NoError(t, DB().First(&entity).Error)
+	assert.Equal(t, "tenant", entity.TenantID)
+}
@@ -0,0 +1,411 @@
+package model
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// defaultTenantEnvKey names the tenant of the subscriptions created before the tenant scoping.
+const defaultTenantEnvKey = "DEFAULT_TENANT_ID"
+
+// migrations are the versioned changes of the database schema, a released migration is never changed.
+// Every migration works on its own copy of the models, so it keeps creating the same schema when
+// the models change later.
+var migrations = []Migration{
+	{
+		Version:     1,
+		Description: "create subscribes and subscribe_entities",
+		Up: func(tx *gorm.DB) error {
+			// the tables of the deployments created before the migrations are kept as they are
+			return tx.AutoMigrate(&subscribeV1{}, &subscribeEntitiesV1{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&subscribeEntitiesV1{}, &subscribeV1{})
+		},
+	},
+	{
+		Version:     2,
+		Description: "create outboxes",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&outboxV2{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&outboxV2{})
+		},
+	},
+	{
+		Version:     3,
+		Description: "create core_subscriptions",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&coreSubscriptionV3{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&coreSubscriptionV3{})
+		},
+	},
+	{
+		Version:     4,
+		Description: "scope subscribes and subscribe_entities by tenant",
+		Up: func(tx *gorm.DB) error {
+			for _, m := range []interface{}{&subscribeV4{}, &subscribeEntitiesV4{}} {
+				if err := addIndexedColumn(tx, m, "TenantID"); err != nil {
+					return err
+				}
+			}
+			return backfillTenant(tx, os.Getenv(defaultTenantEnvKey))
+		},
+		Down: func(tx *gorm.DB) error {
+			for _, m := range []interface{}{&subscribeV4{}, &subscribeEntitiesV4{}} {
+				if err := dropIndexedColumn(tx, m, "TenantID"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Version:     5,
+		Description: "keep one default subscribe per user",
+		Up: func(tx *gorm.DB) error {
+			if err := addIndexedColumn(tx, &subscribeV5{}, "DefaultOwner"); err != nil {
+				return err
+			}
+			return backfillDefaultOwner(tx)
+		},
+		Down: func(tx *gorm.DB) error {
+			return dropIndexedColumn(tx, &subscribeV5{}, "DefaultOwner")
+		},
+	},
+	{
+		Version:     6,
+		Description: "create audit_events and audit_entities",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&auditEventV6{}, &auditEntityV6{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&auditEntityV6{}, &auditEventV6{})
+		},
+	},
+	{
+		Version:     7,
+		Description: "create archived_subscribe_entities",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&archivedSubscribeEntityV7{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&archivedSubscribeEntityV7{})
+		},
+	},
+	{
+		Version:     8,
+		Description: "create selectors and selector_entities",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&selectorV8{}, &selectorEntityV8{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&selectorEntityV8{}, &selectorV8{})
+		},
+	},
+	{
+		Version:     9,
+		Description: "record when entities are subscribed",
+		Up: func(tx *gorm.DB) error {
+			if err := addIndexedColumn(tx, &subscribeEntitiesV9{}, "CreatedAt"); err != nil {
+				return err
+			}
+			// the time the entities were subscribed before is unknown
+			return tx.Model(&subscribeEntitiesV9{}).Where("created_at IS NULL").
+				UpdateColumn("created_at", time.Now()).Error
+		},
+		Down: func(tx *gorm.DB) error {
+			return dropIndexedColumn(tx, &subscribeEntitiesV9{}, "CreatedAt")
+		},
+	},
+	{
+		Version:     10,
+		Description: "mark subscribes applied from manifests",
+		Up: func(tx *gorm.DB) error {
+			if !tx.Migrator().HasColumn(&subscribeV10{}, "Managed") {
+				if err := tx.Migrator().AddColumn(&subscribeV10{}, "Managed"); err != nil {
+					return err
+				}
+			}
+			return addIndexedColumn(tx, &subscribeV10{}, "Manifest")
+		},
+		Down: func(tx *gorm.DB) error {
+			if err := dropIndexedColumn(tx, &subscribeV10{}, "Manifest"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn(&subscribeV10{}, "Managed")
+		},
+	},
+	{
+		Version:     11,
+		Description: "add status to subscribes",
+		Up: func(tx *gorm.DB) error {
+			return addIndexedColumn(tx, &subscribeV11{}, "Status")
+		},
+		Down: func(tx *gorm.DB) error {
+			return dropIndexedColumn(tx, &subscribeV11{}, "Status")
+		},
+	},
+	{
+		Version:     12,
+		Description: "create entity_locks",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&entityLockV12{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&entityLockV12{})
+		},
+	},
+	{
+		Version:     13,
+		Description: "create redeliveries",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&redeliveryV13{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&redeliveryV13{})
+		},
+	},
+}
+
+type subscribeV1 struct {
+	gorm.Model
+	Title       string `gorm:"not null"`
+	Description string
+	UserID      string `gorm:"index"`
+	Endpoint    string `gorm:"index"`
+	IsDefault   bool   `gorm:"default:false"`
+}
+
+func (subscribeV1) TableName() string { return "subscribes" }
+
+type subscribeV4 struct {
+	subscribeV1
+	TenantID string `gorm:"index;not null;default:''"`
+}
+
+func (subscribeV4) TableName() string { return "subscribes" }
+
+type subscribeV5 struct {
+	subscribeV4
+	DefaultOwner *string `gorm:"uniqueIndex;size:255"`
+}
+
+func (subscribeV5) TableName() string { return "subscribes" }
+
+type subscribeV10 struct {
+	subscribeV5
+	Managed  bool   `gorm:"default:false"`
+	Manifest string `gorm:"index;size:255"`
+}
+
+func (subscribeV10) TableName() string { return "subscribes" }
+
+type subscribeV11 struct {
+	subscribeV10
+	Status string `gorm:"index;size:32;not null;default:'active'"`
+}
+
+func (subscribeV11) TableName() string { return "subscribes" }
+
+type subscribeEntitiesV1 struct {
+	EntityID    string `gorm:"index;not null"`
+	UniqueKey   string `gorm:"index;unique;size:255"`
+	SubscribeID uint   `gorm:"index;not null"`
+
+	Subscribe subscribeV1
+}
+
+func (subscribeEntitiesV1) TableName() string { return "subscribe_entities" }
+
+type subscribeEntitiesV4 struct {
+	TenantID    string `gorm:"index;not null;default:''"`
+	EntityID    string `gorm:"index;not null"`
+	UniqueKey   string `gorm:"index;unique;size:255"`
+	SubscribeID uint   `gorm:"index;not null"`
+}
+
+func (subscribeEntitiesV4) TableName() string { return "subscribe_entities" }
+
+type subscribeEntitiesV9 struct {
+	TenantID    string    `gorm:"index;not null;default:''"`
+	EntityID    string    `gorm:"index;not null"`
+	UniqueKey   string    `gorm:"index;unique;size:255"`
+	SubscribeID uint      `gorm:"index;not null"`
+	CreatedAt   time.Time `gorm:"index"`
+}
+
+func (subscribeEntitiesV9) TableName() string { return "subscribe_entities" }
+
+// addIndexedColumn adds the column of field and its index unless they exist.
+func addIndexedColumn(tx *gorm.DB, m interface{}, field string) error {
+	if !tx.Migrator().HasColumn(m, field) {
+		if err := tx.Migrator().AddColumn(m, field); err != nil {
+			return err
+		}
+	}
+	if !tx.Migrator().HasIndex(m, field) {
+		return tx.Migrator().CreateIndex(m, field)
+	}
+	return nil
+}
+
+func dropIndexedColumn(tx *gorm.DB, m interface{}, field string) error {
+	if tx.Migrator().HasIndex(m, field) {
+		if err := tx.Migrator().DropIndex(m, field); err != nil {
+			return err
+		}
+	}
+	return tx.Migrator().DropColumn(m, field)
+}
+
+// backfillTenant moves the subscriptions without tenant into tenant, then copies
+// the tenant of every subscription to its entities.
+func backfillTenant(tx *gorm.DB, tenant string) error {
+	var count int64
+	if err := tx.Model(&subscribeV4{}).Unscoped().Where("tenant_id = ?", "").Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		if tenant == "" {
+			return errors.Errorf("%d subscribes have no tenant, set %s to the tenant owning them", count, defaultTenantEnvKey)
+		}
+		if err := tx.Model(&subscribeV4{}).Unscoped().Where("tenant_id = ?", "").
+			UpdateColumn("tenant_id", tenant).Error; err != nil {
+			return err
+		}
+	}
+	return tx.Exec("UPDATE subscribe_entities SET tenant_id = " +
+		"(SELECT subscribes.tenant_id FROM subscribes WHERE subscribes.id = subscribe_entities.subscribe_id) " +
+		"WHERE tenant_id = ''").Error
+}
+
+// backfillDefaultOwner keeps the oldest default subscription of every user as the default one.
+func backfillDefaultOwner(tx *gorm.DB) error {
+	defaults := make([]subscribeV5, 0)
+	if err := tx.Where("is_default = ? AND default_owner IS NULL", true).Order("id").Find(&defaults).Error; err != nil {
+		return err
+	}
+	owners := make(map[string]bool)
+	for _, sub := range defaults {
+		owner := *defaultOwner(sub.TenantID, sub.UserID)
+		updates := map[string]interface{}{"default_owner": owner}
+		if owners[owner] {
+			updates = map[string]interface{}{"is_default": false}
+		}
+		owners[owner] = true
+		if err := tx.Model(&subscribeV5{}).Where("id = ?", sub.ID).UpdateColumns(updates).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type outboxV2 struct {
+	ID          uint `gorm:"primarykey"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	SubscribeID uint   `gorm:"index;not null"`
+	EntityID    string `gorm:"index;not null"`
+	Endpoint    string `gorm:"not null"`
+	Operation   string `gorm:"size:32;not null"`
+	Status      string `gorm:"index;size:32;not null"`
+	Steps       uint8
+	Attempts    int
+	LastError   string    `gorm:"size:1024"`
+	NextRunAt   time.Time `gorm:"index"`
+}
+
+func (outboxV2) TableName() string { return "outboxes" }
+
+type coreSubscriptionV3 struct {
+	EntityID       string `gorm:"primarykey;size:255"`
+	SubscriptionID string `gorm:"uniqueIndex;size:64;not null"`
+	CreatedAt      time.Time
+}
+
+func (coreSubscriptionV3) TableName() string { return "core_subscriptions" }
+
+type auditEventV6 struct {
+	ID          uint      `gorm:"primarykey"`
+	CreatedAt   time.Time `gorm:"index"`
+	TenantID    string    `gorm:"index;size:255;not null"`
+	Actor       string    `gorm:"index;size:255;not null"`
+	Action      string    `gorm:"size:64;not null"`
+	SubscribeID uint      `gorm:"index"`
+	Before      string    `gorm:"type:text"`
+	After       string    `gorm:"type:text"`
+	Outcome     string    `gorm:"size:32;not null"`
+	Error       string    `gorm:"size:1024"`
+}
+
+func (auditEventV6) TableName() string { return "audit_events" }
+
+type auditEntityV6 struct {
+	ID           uint   `gorm:"primarykey"`
+	AuditEventID uint   `gorm:"index;not null"`
+	EntityID     string `gorm:"index;size:255;not null"`
+}
+
+func (auditEntityV6) TableName() string { return "audit_entities" }
+
+type archivedSubscribeEntityV7 struct {
+	ID          uint   `gorm:"primarykey"`
+	TenantID    string `gorm:"index;not null;default:''"`
+	SubscribeID uint   `gorm:"index;not null"`
+	EntityID    string `gorm:"size:255;not null"`
+	ArchivedAt  time.Time
+}
+
+func (archivedSubscribeEntityV7) TableName() string { return "archived_subscribe_entities" }
+
+type selectorV8 struct {
+	ID               uint `gorm:"primarykey"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	TenantID         string `gorm:"index;not null;default:''"`
+	SubscribeID      uint   `gorm:"index;not null"`
+	Kind             string `gorm:"size:32;not null"`
+	Value            string `gorm:"size:255"`
+	IncludeSubgroups bool
+	Conditions       string `gorm:"type:text"`
+	LastSyncedAt     *time.Time
+	LastError        string `gorm:"size:1024"`
+}
+
+func (selectorV8) TableName() string { return "selectors" }
+
+type selectorEntityV8 struct {
+	ID          uint   `gorm:"primarykey"`
+	SelectorID  uint   `gorm:"uniqueIndex:idx_selector_entity;not null"`
+	SubscribeID uint   `gorm:"index;not null"`
+	EntityID    string `gorm:"uniqueIndex:idx_selector_entity;size:255;not null"`
+}
+
+func (selectorEntityV8) TableName() string { return "selector_entities" }
+
+type entityLockV12 struct {
+	EntityID string `gorm:"primarykey;size:255"`
+	LockedAt time.Time
+}
+
+func (entityLockV12) TableName() string { return "entity_locks" }
+
+type redeliveryV13 struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	EntityID  string `gorm:"index;size:255;not null"`
+	Endpoint  string `gorm:"size:255;not null"`
+	Data      string `gorm:"type:text;not null"`
+	Attempts  int
+	LastError string    `gorm:"size:1024"`
+	NextRunAt time.Time `gorm:"index"`
+}
+
+func (redeliveryV13) TableName() string { return "redeliveries" }
@@ -6,26 +6,37 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/pkg/errors"
 	"github.com/tkeel-io/core-broker/pkg/core"
 	"github.com/tkeel-io/core-broker/pkg/pagination"
 	"github.com/tkeel-io/kit/log"
 
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const (
-	// schema like: "user:pass@tcp(127.0.0.1:3306)/dbname?charset=utf8mb4&parseTime=True&loc=Local"
+	// schema like: "user:pass@tcp(127.0.0.1:3306)/dbname?charset=utf8mb4&parseTime=True&loc=Local",
+	// the scheme chooses another driver, see parseDSN.
 	dsnFromOSEnvKey = "DSN"
 	amqpServer      = "AMQP_SERVER"
 )
 
 type WhereOptions func() (query interface{}, args interface{})
 
+// coreAPI is the part of core.Client used by the models.
+type coreAPI interface {
+	Subscribe(subscriptionID, entityID, topic string) error
+	Unsubscribe(subscriptionID string) error
+	GetDeviceEntity(entityID string) (*core.Entity, error)
+	PatchEntity(entityID string, data []map[string]interface{}) error
+	Publish(topic string, data interface{}) error
+}
+
 var (
 	_once      sync.Once
 	db         *gorm.DB
-	coreClient *core.Client
+	coreClient coreAPI
 
 	AMQPServerAddr = "amqp://localhost:3172"
 )
@@ -42,25 +53,22 @@ func Setup() error {
 		AMQPServerAddr = amqpServerStr
 	}
 
-	dsn := os.Getenv(dsnFromOSEnvKey)
-
-	// Try to create DB first.
-	connectionInfo, dbName := withoutDBConnectionAndDBName(dsn)
-	noDBConnection, err := gorm.Open(mysql.Open(connectionInfo), nil)
-	if err != nil {
+	if err = LoadQuota(); err != nil {
 		log.Fatal(err)
 	}
 
-	if err = noDBConnection.Exec(createDBSQL(dbName)).Error; err != nil {
+	if err = Open(); err != nil {
 		log.Fatal(err)
 	}
+	// the schema is changed by "core-broker migrate up" only
+	return CheckSchema()
+}
 
-	// Open the DB
-	db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
-	if err != nil {
-		log.Fatal(err)
-	}
-	return db.AutoMigrate(&Subscribe{}, &SubscribeEntities{})
+// Open opens the database of the DSN environment variable without checking its schema.
+func Open() error {
+	var err error
+	db, err = openDB(os.Getenv(dsnFromOSEnvKey))
+	return err
 }
 
 func AMQPAddressString(endpoint string) string {
@@ -79,22 +87,138 @@ func DB() *gorm.DB {
 	return db
 }
 
-func Paginate(find interface{}, page pagination.Page, where interface{}, args ...interface{}) *gorm.DB {
-	conditions, fields := page.SearchCondition()
+// ErrInvalidPageField is returned when a page orders or searches by a field which is not in the fields of the model.
+var ErrInvalidPageField = errors.New("invalid page field")
 
-	if conditions != nil && fields == nil {
-		return DB().Where(where, args...).Where(conditions).Limit(int(page.Limit())).Offset(int(page.Offset())).Find(find)
+// PageFields maps the field names a list can be ordered and searched by in the API to the columns of the model.
+type PageFields map[string]string
+
+// Fields of the models which are paginated.
+var (
+	SubscribePageFields = PageFields{
+		"id":          "id",
+		"title":       "title",
+		"description": "description",
+		"endpoint":    "endpoint",
+		"is_default":  "is_default",
+		"status":      "status",
+		"created_at":  "created_at",
+		"updated_at":  "updated_at",
+	}
+	OutboxPageFields = PageFields{
+		"id":          "id",
+		"entity_id":   "entity_id",
+		"operation":   "operation",
+		"status":      "status",
+		"attempts":    "attempts",
+		"created_at":  "created_at",
+		"updated_at":  "updated_at",
+		"next_run_at": "next_run_at",
 	}
+)
 
-	if conditions != nil && fields != nil {
-		return DB().Select(fields).Where(where, args...).Where(conditions).Limit(int(page.Limit())).Offset(int(page.Offset())).Find(find)
+func (f PageFields) column(field string) (string, error) {
+	column, ok := f[strings.TrimSpace(field)]
+	if !ok {
+		return "", errors.Wrapf(ErrInvalidPageField, "%q", field)
 	}
+	return column, nil
+}
 
-	if conditions == nil && fields != nil {
-		return DB().Select(fields).Where(where, args...).Limit(int(page.Limit())).Offset(int(page.Offset())).Find(find)
+// searchPage returns the query of where filtered by the key words of the page, every key word must be LIKE
+// the field of the search key at the same position.
+func searchPage(page pagination.Page, fields PageFields, where interface{}, args ...interface{}) (*gorm.DB, error) {
+	query := DB().Where(where, args...)
+	conditions, _ := page.SearchCondition()
+	for field, value := range conditions {
+		column, err := fields.column(field)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(clause.Expr{
+			SQL:  "? LIKE ? ESCAPE ?",
+			Vars: []interface{}{clause.Column{Name: column}, "%" + escapeLike(value) + "%", `\`},
+		})
 	}
+	return query, nil
+}
+
+// Paginate finds a page of the records of where, searched, ordered and selected by the fields of the page.
+// A field missing from fields fails with ErrInvalidPageField. The page is not limited unless it is required
+// or starts after a cursor, the records are ordered by id unless the page orders them by another field.
+// A page starting after a cursor is ordered by id only.
+func Paginate(find interface{}, page pagination.Page, fields PageFields, where interface{}, args ...interface{}) *gorm.DB {
+	query, err := searchPage(page, fields, where, args...)
+	if err != nil {
+		return &gorm.DB{Error: err}
+	}
+	if _, selected := page.SearchCondition(); selected != nil {
+		columns := make([]string, 0, len(selected))
+		for _, field := range selected {
+			column, err := fields.column(field)
+			if err != nil {
+				return &gorm.DB{Error: err}
+			}
+			columns = append(columns, column)
+		}
+		query = query.Select(columns)
+	}
+	column := "id"
+	if page.OrderBy != "" {
+		if column, err = fields.column(page.OrderBy); err != nil {
+			return &gorm.DB{Error: err}
+		}
+	}
+	if page.Cursor != nil {
+		if column != "id" {
+			return &gorm.DB{Error: errors.Wrapf(ErrInvalidPageField, "page token with order by %q", page.OrderBy)}
+		}
+		op := ">"
+		if page.IsDescending {
+			op = "<"
+		}
+		query = query.Where("id "+op+" ?", page.Cursor.ID).Limit(int(page.Limit()))
+	} else if page.Required() {
+		query = query.Limit(int(page.Limit())).Offset(int(page.Offset()))
+	}
+	return query.Order(clause.OrderByColumn{Column: clause.Column{Name: column}, Desc: page.IsDescending}).Find(find)
+}
+
+// SetNextPage sets the token of the page after the count records found by Paginate, lastID is the ID of the last one.
+// Only the pages ordered by id have a next page token.
+func SetNextPage(page *pagination.Page, count int, lastID uint) {
+	if page.OrderBy == "" || page.OrderBy == "id" {
+		page.SetNext(pagination.Cursor{ID: uint64(lastID)}, count)
+	}
+}
+
+// afterCursor filters query to the rows after the cursor of a page ordered by column then key, keyValue is
+// the value of key in the cursor.
+func afterCursor(query *gorm.DB, column, key string, cursor *pagination.Cursor, keyValue interface{}, desc bool) *gorm.DB {
+	if cursor.Time == nil {
+		_ = query.AddError(errors.Wrap(pagination.ErrInvalidPageToken, "page token without time"))
+		return query
+	}
+	op := ">"
+	if desc {
+		op = "<"
+	}
+	return query.Where(fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", column, op, column, key, op),
+		*cursor.Time, *cursor.Time, keyValue)
+}
+
+// CountPage counts the records of where searched by the page, as Paginate does.
+func CountPage(count *int64, model interface{}, page pagination.Page, fields PageFields, where interface{}, args ...interface{}) error {
+	query, err := searchPage(page, fields, where, args...)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(query.Model(model).Count(count).Error, "count page")
+}
 
-	return DB().Where(where, args...).Limit(int(page.Limit())).Offset(int(page.Offset())).Find(find)
+// escapeLike escapes the wildcards of a LIKE pattern.
+func escapeLike(value string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(value)
 }
 
 func ListAll(find interface{}, where interface{}, args ...interface{}) *gorm.DB {
@@ -5,7 +5,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/pagination"
 )
 
 func TestWithoutDBConnectionAndDBName(t *testing.T) {
@@ -20,3 +22,79 @@ func TestWithoutDBConnectionAndDBName(t *testing.T) {
 	assert.Equal(t, expectDBNName, dbName)
 	assert.Equal(t, expectConnection, connection)
 }
+
+type pageRequest struct {
+	PageNum      uint64
+	PageSize     uint64
+	OrderBy      string
+	IsDescending bool
+	KeyWords     string
+	SearchKey    string
+}
+
+func TestPaginate(t *testing.T) {
+	useTestDB(t)
+	for _, title := range []string{"b-sub", "a-sub", "c_sub", "c-sub"} {
+		assert.NoError(t, DB().Create(&Subscribe{TenantID: "tenant", UserID: "user", Title: title}).Error)
+	}
+	assert.NoError(t, DB().Create(&Subscribe{TenantID: "tenant", UserID: "other", Title: "a-sub"}).Error)
+	where := &Subscribe{TenantID: "tenant", UserID: "user"}
+
+	titles := func(subscribes []Subscribe) []string {
+		items := make([]string, 0, len(subscribes))
+		for i := range subscribes {
+			items = append(items, subscribes[i].Title)
+		}
+		return items
+	}
+
+	tests := []struct {
+		name     string
+		page     pageRequest
+		excepted []string
+		total    int64
+	}{
+		{"order by title", pageRequest{OrderBy: "title"}, []string{"a-sub", "b-sub", "c-sub", "c_sub"}, 4},
+		{"descending", pageRequest{OrderBy: "title", IsDescending: true, PageNum: 1, PageSize: 2}, []string{"c_sub", "c-sub"}, 4},
+		{"descending by id", pageRequest{IsDescending: true}, []string{"c-sub", "c_sub", "a-sub", "b-sub"}, 4},
+		{"search", pageRequest{SearchKey: "title", KeyWords: "c", OrderBy: "id"}, []string{"c_sub", "c-sub"}, 2},
+		{"search escapes wildcards", pageRequest{SearchKey: "title", KeyWords: "_"}, []string{"c_sub"}, 1},
+	}
+	for _, test := range tests {
+		page, err := pagination.Parse(&test.page)
+		assert.NoError(t, err)
+		subscribes := make([]Subscribe, 0)
+		assert.NoError(t, Paginate(&subscribes, page, SubscribePageFields, where).Error, test.name)
+		assert.Equal(t, test.excepted, titles(subscribes), test.name)
+		var total int64
+		assert.NoError(t, CountPage(&total, &Subscribe{}, page, SubscribePageFields, where), test.name)
+		assert.Equal(t, test.total, total, test.name)
+	}
+
+	page := pagination.Page{Num: 1, Size: 3}
+	first := make([]Subscribe, 0)
+	assert.NoError(t, Paginate(&first, page, SubscribePageFields, where).Error)
+	SetNextPage(&page, len(first), first[len(first)-1].ID)
+	page, err := pagination.Parse(&struct {
+		PageSize  uint64
+		PageToken string
+	}{PageSize: 3, PageToken: page.NextToken})
+	assert.NoError(t, err)
+	next := make([]Subscribe, 0)
+	assert.NoError(t, Paginate(&next, page, SubscribePageFields, where).Error)
+	assert.Equal(t, []string{"c-sub"}, titles(next), "after the page token")
+	page.OrderBy = "title"
+	assert.True(t, errors.Is(Paginate(&next, page, SubscribePageFields, where).Error, ErrInvalidPageField), "page token ordered by title")
+
+	for _, req := range []pageRequest{
+		{OrderBy: "tenant_id"},
+		{OrderBy: "title; DROP TABLE subscribes"},
+		{SearchKey: "user_id", KeyWords: "user"},
+		{SearchKey: "title,tenant_id", KeyWords: "a"},
+	} {
+		page, err := pagination.Parse(&req)
+		assert.NoError(t, err)
+		subscribes := make([]Subscribe, 0)
+		assert.True(t, errors.Is(Paginate(&subscribes, page, SubscribePageFields, where).Error, ErrInvalidPageField), req.OrderBy+req.SearchKey)
+	}
+}
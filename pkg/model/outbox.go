@@ -0,0 +1,381 @@
+package model
+
+import (
+	"context"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+)
+
+// Operations recorded in the outbox.
+const (
+	OperationSubscribe   = "subscribe"
+	OperationUnsubscribe = "unsubscribe"
+)
+
+// Status of an outbox operation.
+const (
+	OutboxPending = "pending"
+	OutboxRunning = "running"
+	OutboxDone    = "done"
+	OutboxFailed  = "failed"
+)
+
+// Steps of an outbox operation, every applied step is recorded so a retry never repeats it.
+const (
+	stepCore = 1 << iota
+	stepAddress
+)
+
+const (
+	defaultDispatchInterval = time.Second
+	defaultDispatchBatch    = 1000
+	defaultDispatchWorkers  = 16
+	defaultMaxAttempts      = 10
+	defaultClaimTimeout     = 5 * time.Minute
+	maxRetryBackoff         = 5 * time.Minute
+
+	// outboxRetentionEnvKey is how long the done operations are kept, like "168h", "0" keeps them forever.
+	outboxRetentionEnvKey = "OUTBOX_RETENTION"
+
+	DefaultOutboxRetention     = 7 * 24 * time.Hour
+	defaultOutboxPurgeInterval = time.Hour
+)
+
+// Outbox is a core operation recorded in the same transaction as the SubscribeEntities change causing it,
+// the OutboxDispatcher applies it on core afterwards.
+type Outbox struct {
+	ID          uint `gorm:"primarykey"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	SubscribeID uint   `gorm:"index;not null"`
+	EntityID    string `gorm:"index;not null"`
+	Endpoint    string `gorm:"not null"`
+	Operation   string `gorm:"size:32;not null"`
+	Status      string `gorm:"index;size:32;not null"`
+	Steps       uint8
+	Attempts    int
+	LastError   string    `gorm:"size:1024"`
+	NextRunAt   time.Time `gorm:"index"`
+}
+
+// enqueueOutbox records the operation on relation e in the transaction tx, e.Subscribe must be loaded.
+// Nothing is recorded for a paused subscription, ResumeSubscribe records the operations of its entities.
+func enqueueOutbox(tx *gorm.DB, e *SubscribeEntities, operation string) error {
+	if e.Subscribe.Paused() {
+		log.Debugf("skip %s of entity %s, subscribe %d is paused", operation, e.EntityID, e.SubscribeID)
+		return nil
+	}
+	o := &Outbox{
+		SubscribeID: e.SubscribeID,
+		EntityID:    e.EntityID,
+		Endpoint:    e.Subscribe.Endpoint,
+		Operation:   operation,
+		Status:      OutboxPending,
+		NextRunAt:   time.Now(),
+	}
+	if err := tx.Session(&gorm.Session{NewDB: true}).Create(o).Error; err != nil {
+		return errors.Wrapf(err, "record %s of entity %s in outbox", operation, e.EntityID)
+	}
+	return nil
+}
+
+// recordOutbox records the operation on the entities of the subscription in the transaction tx, in bulk.
+func recordOutbox(tx *gorm.DB, subscribe *Subscribe, entityIDs []string, operation string) error {
+	if len(entityIDs) == 0 {
+		return nil
+	}
+	now := time.Now()
+	operations := make([]Outbox, 0, len(entityIDs))
+	for _, id := range entityIDs {
+		operations = append(operations, Outbox{
+			SubscribeID: subscribe.ID,
+			EntityID:    id,
+			Endpoint:    subscribe.Endpoint,
+			Operation:   operation,
+			Status:      OutboxPending,
+			NextRunAt:   now,
+		})
+	}
+	err := tx.Session(&gorm.Session{NewDB: true}).CreateInBatches(&operations, DefaultBulkBatchSize).Error
+	return errors.Wrapf(err, "record %s operations in outbox", operation)
+}
+
+// apply runs the steps of o which have not been applied yet.
+func (o *Outbox) apply() error {
+	addr := subscribeuril.NewAddress(o.SubscribeID, AMQPAddressString(o.Endpoint))
+	switch o.Operation {
+	case OperationSubscribe:
+		if o.Steps&stepCore == 0 {
+			if err := attachEntity(o.EntityID); err != nil {
+				return errors.Wrap(err, "attach entity err")
+			}
+			o.Steps |= stepCore
+		}
+		if o.Steps&stepAddress == 0 {
+			if err := updateEntitySubscribeEndpoint(o.EntityID, addr, Add); err != nil {
+				return errors.Wrap(err, "update entity subscribe endpoint err")
+			}
+			o.Steps |= stepAddress
+		}
+	case OperationUnsubscribe:
+		if o.Steps&stepAddress == 0 {
+			if err := updateEntitySubscribeEndpoint(o.EntityID, addr, Reduce); err != nil {
+				return errors.Wrap(err, "update entity subscribe endpoint err")
+			}
+			o.Steps |= stepAddress
+		}
+		if o.Steps&stepCore == 0 {
+			if err := detachEntity(o.EntityID); err != nil {
+				return errors.Wrap(err, "detach entity err")
+			}
+			o.Steps |= stepCore
+		}
+	default:
+		return errors.Errorf("unknown outbox operation %q", o.Operation)
+	}
+	return nil
+}
+
+// OutboxDispatcher applies the pending outbox operations on core with up to Workers entities in parallel.
+// Operations on the same entity are applied one by one in the order they were recorded. An operation is
+// claimed before it is applied, so the dispatchers of several replicas never apply it twice, a claim not
+// released within ClaimTimeout is taken as abandoned.
+type OutboxDispatcher struct {
+	Interval     time.Duration
+	BatchSize    int
+	Workers      int
+	MaxAttempts  int
+	ClaimTimeout time.Duration
+}
+
+func NewOutboxDispatcher() *OutboxDispatcher {
+	return &OutboxDispatcher{
+		Interval:     defaultDispatchInterval,
+		BatchSize:    defaultDispatchBatch,
+		Workers:      defaultDispatchWorkers,
+		MaxAttempts:  defaultMaxAttempts,
+		ClaimTimeout: defaultClaimTimeout,
+	}
+}
+
+// Run dispatches pending operations until ctx is done.
+func (d *OutboxDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// keep dispatching while there is progress, a bulk subscription should not wait for the ticker
+			for ctx.Err() == nil {
+				applied, err := d.Dispatch()
+				if err != nil {
+					log.Error("dispatch outbox err:", err)
+				}
+				if applied == 0 {
+					break
+				}
+			}
+		}
+	}
+}
+
+// Dispatch applies one batch of due pending operations and returns how many were applied. The operations
+// behind an operation on the same entity which is backing off or being applied are not due.
+func (d *OutboxDispatcher) Dispatch() (int, error) {
+	now := time.Now()
+	// the claims of a dispatcher which stopped while applying them have expired
+	if err := DB().Model(&Outbox{}).Where("status = ? AND next_run_at <= ?", OutboxRunning, now).
+		UpdateColumn("status", OutboxPending).Error; err != nil {
+		return 0, errors.Wrap(err, "release expired outbox claims")
+	}
+	blocking := DB().Table("outboxes AS earlier").Select("1").
+		Where("earlier.entity_id = outboxes.entity_id AND earlier.id < outboxes.id").
+		Where("(earlier.status = ? OR (earlier.status = ? AND earlier.next_run_at > ?))", OutboxRunning, OutboxPending, now)
+	pending := make([]*Outbox, 0)
+	if err := DB().Where("status = ? AND next_run_at <= ?", OutboxPending, now).Where("NOT EXISTS (?)", blocking).
+		Order("id").Limit(d.BatchSize).Find(&pending).Error; err != nil {
+		return 0, errors.Wrap(err, "list pending outbox")
+	}
+
+	// group the operations by entity, keeping their order
+	entities := make([]string, 0)
+	queues := make(map[string][]*Outbox)
+	for _, o := range pending {
+		if _, ok := queues[o.EntityID]; !ok {
+			entities = append(entities, o.EntityID)
+		}
+		queues[o.EntityID] = append(queues[o.EntityID], o)
+	}
+
+	workers := d.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+	var applied int64
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, workers)
+	for _, entityID := range entities {
+		queue := queues[entityID]
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			for _, o := range queue {
+				// a failed operation, or one claimed by another dispatcher, keeps the later operations on the entity behind it
+				claimed, err := d.claim(o)
+				if err != nil {
+					log.Errorf("claim outbox %d err: %v", o.ID, err)
+					return
+				}
+				if !claimed {
+					return
+				}
+				if err := d.dispatchOne(o); err != nil {
+					return
+				}
+				atomic.AddInt64(&applied, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	return int(applied), nil
+}
+
+// ErrOutboxClaimLost is returned when the claim of an operation expired while it was applied and another
+// dispatcher claimed it meanwhile, the outcome of the operation is then not saved.
+var ErrOutboxClaimLost = errors.New("outbox claim lost")
+
+// claim marks the due pending operation o running until the claim expires, it returns false when another
+// dispatcher has claimed or applied it meanwhile. The expiry, kept in o.NextRunAt, identifies the claim and
+// is truncated to the second so every database stores it as it is.
+func (d *OutboxDispatcher) claim(o *Outbox) (bool, error) {
+	now := time.Now()
+	expires := now.Add(d.ClaimTimeout).Truncate(time.Second)
+	result := DB().Model(&Outbox{}).Where("id = ? AND status = ? AND next_run_at <= ?", o.ID, OutboxPending, now).
+		UpdateColumns(map[string]interface{}{"status": OutboxRunning, "next_run_at": expires})
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "claim outbox")
+	}
+	o.Status, o.NextRunAt = OutboxRunning, expires
+	return result.RowsAffected == 1, nil
+}
+
+// dispatchOne applies the claimed operation o and saves its outcome unless the claim was lost meanwhile.
+func (d *OutboxDispatcher) dispatchOne(o *Outbox) error {
+	claimed := o.NextRunAt
+	err := o.apply()
+	o.Attempts++
+	if err == nil {
+		o.Status = OutboxDone
+		o.LastError = ""
+	} else {
+		log.Errorf("apply outbox %d (%s entity %s) attempt %d err: %v", o.ID, o.Operation, o.EntityID, o.Attempts, err)
+		o.LastError = truncate(err.Error(), 1024)
+		if o.Attempts >= d.MaxAttempts {
+			o.Status = OutboxFailed
+		} else {
+			o.Status = OutboxPending
+			o.NextRunAt = time.Now().Add(retryBackoff(o.Attempts))
+		}
+	}
+	result := DB().Model(&Outbox{}).Where("id = ? AND status = ? AND next_run_at = ?", o.ID, OutboxRunning, claimed).
+		UpdateColumns(map[string]interface{}{
+			"status":      o.Status,
+			"steps":       o.Steps,
+			"attempts":    o.Attempts,
+			"last_error":  o.LastError,
+			"next_run_at": o.NextRunAt,
+			"updated_at":  time.Now(),
+		})
+	saveErr := errors.Wrap(result.Error, "save outbox")
+	if saveErr == nil && result.RowsAffected == 0 {
+		saveErr = errors.Wrapf(ErrOutboxClaimLost, "outbox %d", o.ID)
+	}
+	if saveErr != nil {
+		log.Errorf("save outbox %d err: %v", o.ID, saveErr)
+		if err == nil {
+			err = saveErr
+		}
+	}
+	return err
+}
+
+func retryBackoff(attempts int) time.Duration {
+	backoff := time.Second << uint(attempts)
+	if backoff <= 0 || backoff > maxRetryBackoff {
+		return maxRetryBackoff
+	}
+	return backoff
+}
+
+func truncate(s string, size int) string {
+	if len(s) <= size {
+		return s
+	}
+	return s[:size]
+}
+
+// OutboxRetention returns how long the done operations are kept, zero keeps them forever.
+func OutboxRetention() (time.Duration, error) {
+	env := os.Getenv(outboxRetentionEnvKey)
+	if env == "" {
+		return DefaultOutboxRetention, nil
+	}
+	retention, err := time.ParseDuration(env)
+	if err != nil || retention < 0 {
+		return 0, errors.Errorf("invalid %s %q, want a non-negative duration like \"168h\"", outboxRetentionEnvKey, env)
+	}
+	return retention, nil
+}
+
+// PurgeDoneOutbox removes the operations done before deadline and returns how many were removed, the pending
+// and failed operations are kept.
+func PurgeDoneOutbox(deadline time.Time) (int64, error) {
+	result := DB().Where("status = ? AND updated_at < ?", OutboxDone, deadline).Delete(&Outbox{})
+	return result.RowsAffected, errors.Wrap(result.Error, "purge done outbox")
+}
+
+// OutboxPurger removes the operations done more than Retention ago every Interval.
+type OutboxPurger struct {
+	Retention time.Duration
+	Interval  time.Duration
+}
+
+func NewOutboxPurger(retention time.Duration) *OutboxPurger {
+	return &OutboxPurger{Retention: retention, Interval: defaultOutboxPurgeInterval}
+}
+
+// Run purges until ctx is done, it returns at once when the done operations are kept forever.
+func (p *OutboxPurger) Run(ctx context.Context) {
+	if p.Retention == 0 {
+		log.Info("done outbox operations are kept forever")
+		return
+	}
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+	for {
+		purged, err := PurgeDoneOutbox(time.Now().Add(-p.Retention))
+		if err != nil {
+			log.Error("purge done outbox err:", err)
+		} else if purged > 0 {
+			log.Infof("purged %d outbox operations done more than %s ago", purged, p.Retention)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
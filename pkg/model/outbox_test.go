@@ -0,0 +1,209 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
+)
+
+type flakyCore struct {
+	*fakeCore
+	subscribes int
+	patchErr   error
+	// publishErrs fails the publishes to the topics
+	publishErrs map[string]error
+	published   []string
+}
+
+func (f *flakyCore) Subscribe(subscriptionID, entityID, topic string) error {
+	f.subscribes++
+	return nil
+}
+
+func (f *flakyCore) Publish(topic string, data interface{}) error {
+	if err, ok := f.publishErrs[topic]; ok {
+		return err
+	}
+	f.published = append(f.published, topic)
+	return nil
+}
+
+func (f *flakyCore) PatchEntity(entityID string, data []map[string]interface{}) error {
+	if f.patchErr != nil {
+		return f.patchErr
+	}
+	return f.fakeCore.PatchEntity(entityID, data)
+}
+
+func TestOutboxApplySkipsAppliedSteps(t *testing.T) {
+	useTestDB(t)
+	fc := &flakyCore{fakeCore: newFakeCore(), patchErr: errors.New("core unavailable")}
+	coreClient = fc
+	defer func() { coreClient = nil }()
+
+	o := &Outbox{SubscribeID: 1, EntityID: "device", Endpoint: "endpoint", Operation: OperationSubscribe}
+	assert.Error(t, o.apply())
+	assert.Equal(t, uint8(stepCore), o.Steps)
+	assert.Equal(t, 1, fc.subscribes)
+
+	fc.patchErr = nil
+	assert.NoError(t, o.apply())
+	assert.Equal(t, uint8(stepCore|stepAddress), o.Steps)
+	assert.Equal(t, 1, fc.subscribes, "core subscription must not be created twice")
+
+	addrs, err := subscribeuril.ParseAddresses(fc.addrs["device"])
+	assert.NoError(t, err)
+	assert.Equal(t, []subscribeuril.Address{subscribeuril.NewAddress(1, AMQPAddressString("endpoint"))}, addrs)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		excepted string
+	}{
+		{1, "2s"},
+		{3, "8s"},
+		{20, "5m0s"},
+		{100, "5m0s"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.excepted, retryBackoff(tt.attempts).String())
+	}
+}
+
+func TestDispatchDueOperations(t *testing.T) {
+	useTestDB(t)
+	fc := &flakyCore{fakeCore: newFakeCore()}
+	coreClient = fc
+	defer func() { coreClient = nil }()
+	dispatcher := NewOutboxDispatcher()
+	dispatcher.BatchSize = 2
+
+	now := time.Now()
+	later, earlier := now.Add(time.Minute), now.Add(-time.Minute)
+	rows := []*Outbox{
+		{EntityID: "a", Status: OutboxPending, NextRunAt: later},   // backing off
+		{EntityID: "a", Status: OutboxPending, NextRunAt: earlier}, // behind the backing off one
+		{EntityID: "b", Status: OutboxPending, NextRunAt: earlier},
+		{EntityID: "c", Status: OutboxRunning, NextRunAt: later},   // claimed by another dispatcher
+		{EntityID: "c", Status: OutboxPending, NextRunAt: earlier}, // behind the claimed one
+		{EntityID: "d", Status: OutboxRunning, NextRunAt: earlier}, // the claim expired
+	}
+	for _, o := range rows {
+		o.SubscribeID, o.Endpoint, o.Operation = 1, "endpoint", OperationSubscribe
+		assert.NoError(t, DB().Create(o).Error)
+	}
+
+	applied, err := dispatcher.Dispatch()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, applied)
+	excepted := []string{OutboxPending, OutboxPending, OutboxDone, OutboxRunning, OutboxPending, OutboxDone}
+	for i, o := range rows {
+		got := Outbox{}
+		assert.NoError(t, DB().First(&got, o.ID).Error)
+		assert.Equal(t, excepted[i], got.Status, "outbox %d of entity %s", i, o.EntityID)
+	}
+	assert.Equal(t, 2, fc.subscribes)
+}
+
+func TestOutboxClaim(t *testing.T) {
+	useTestDB(t)
+	dispatcher := NewOutboxDispatcher()
+	o := &Outbox{SubscribeID: 1, EntityID: "a", Endpoint: "endpoint", Operation: OperationSubscribe,
+		Status: OutboxPending, NextRunAt: time.Now()}
+	assert.NoError(t, DB().Create(o).Error)
+	stale := *o
+
+	claimed, err := dispatcher.claim(o)
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+	assert.Equal(t, OutboxRunning, o.Status)
+	claimed, err = dispatcher.claim(&stale)
+	assert.NoError(t, err)
+	assert.False(t, claimed, "an operation is claimed once")
+}
+
+func TestDispatchOneLostClaim(t *testing.T) {
+	useTestDB(t)
+	coreClient = &flakyCore{fakeCore: newFakeCore()}
+	defer func() { coreClient = nil }()
+	dispatcher := NewOutboxDispatcher()
+	o := &Outbox{SubscribeID: 1, EntityID: "a", Endpoint: "endpoint", Operation: OperationSubscribe,
+		Status: OutboxPending, NextRunAt: time.Now()}
+	assert.NoError(t, DB().Create(o).Error)
+
+	claimed, err := dispatcher.claim(o)
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+	// the claim expired and another dispatcher claimed the operation again
+	reclaimed := o.NextRunAt.Add(time.Minute)
+	assert.NoError(t, DB().Model(&Outbox{}).Where("id = ?", o.ID).UpdateColumn("next_run_at", reclaimed).Error)
+
+	assert.ErrorIs(t, dispatcher.dispatchOne(o), ErrOutboxClaimLost)
+	got := Outbox{}
+	assert.NoError(t, DB().First(&got, o.ID).Error)
+	assert.Equal(t, OutboxRunning, got.Status, "the outcome is not saved over the other claim")
+	assert.Equal(t, 0, got.Attempts)
+	assert.True(t, reclaimed.Equal(got.NextRunAt))
+
+	// the claim is still held
+	o = &Outbox{SubscribeID: 1, EntityID: "b", Endpoint: "endpoint", Operation: OperationSubscribe,
+		Status: OutboxPending, NextRunAt: time.Now()}
+	assert.NoError(t, DB().Create(o).Error)
+	claimed, err = dispatcher.claim(o)
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+	assert.NoError(t, dispatcher.dispatchOne(o))
+	got = Outbox{}
+	assert.NoError(t, DB().First(&got, o.ID).Error)
+	assert.Equal(t, OutboxDone, got.Status)
+	assert.Equal(t, 1, got.Attempts)
+}
+
+func TestPurgeDoneOutbox(t *testing.T) {
+	useTestDB(t)
+
+	old := time.Now().Add(-2 * time.Hour)
+	rows := []*Outbox{
+		{SubscribeID: 1, EntityID: "a", Endpoint: "endpoint", Operation: OperationSubscribe, Status: OutboxDone},
+		{SubscribeID: 1, EntityID: "b", Endpoint: "endpoint", Operation: OperationSubscribe, Status: OutboxDone},
+		{SubscribeID: 1, EntityID: "c", Endpoint: "endpoint", Operation: OperationSubscribe, Status: OutboxFailed},
+		{SubscribeID: 1, EntityID: "d", Endpoint: "endpoint", Operation: OperationSubscribe, Status: OutboxPending},
+	}
+	for _, row := range rows {
+		assert.NoError(t, DB().Create(row).Error)
+	}
+	assert.NoError(t, DB().Model(&Outbox{}).Where("entity_id IN ?", []string{"a", "c", "d"}).
+		UpdateColumn("updated_at", old).Error)
+
+	purged, err := PurgeDoneOutbox(time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	var entities []string
+	assert.NoError(t, DB().Model(&Outbox{}).Order("entity_id").Pluck("entity_id", &entities).Error)
+	assert.Equal(t, []string{"b", "c", "d"}, entities)
+}
+
+func TestOutboxRetention(t *testing.T) {
+	tests := []struct {
+		env      string
+		excepted time.Duration
+		err      bool
+	}{
+		{"", DefaultOutboxRetention, false},
+		{"24h", 24 * time.Hour, false},
+		{"0", 0, false},
+		{"-1h", 0, true},
+		{"week", 0, true},
+	}
+	for _, test := range tests {
+		t.Setenv(outboxRetentionEnvKey, test.env)
+		retention, err := OutboxRetention()
+		assert.Equal(t, test.err, err != nil, test.env)
+		assert.Equal(t, test.excepted, retention, test.env)
+	}
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Status of a subscription.
+const (
+	SubscribeActive = "active"
+	SubscribePaused = "paused"
+)
+
+// Paused tells whether the subscription is paused, its entities are then not subscribed in core.
+func (s *Subscribe) Paused() bool {
+	return s.Status == SubscribePaused
+}
+
+// lockSubscribeStatus reads the status of the subscription into subscribe in the transaction tx and locks its row,
+// so PauseSubscribe and ResumeSubscribe wait for tx and see the entities it changes.
+func lockSubscribeStatus(tx *gorm.DB, subscribe *Subscribe) error {
+	locked := Subscribe{}
+	if err := tx.Session(&gorm.Session{NewDB: true}).Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("status").Where("id = ?", subscribe.ID).First(&locked).Error; err != nil {
+		return errors.Wrap(err, "lock subscribe status")
+	}
+	subscribe.Status = locked.Status
+	return nil
+}
+
+// PauseSubscribe pauses the subscription in one transaction. Its entities are kept, the outbox rows removing
+// their core subscriptions and the subscription from their sysField._subscribeAddr are recorded, and the entities
+// subscribed or unsubscribed while it is paused record no core operation. Pausing a paused subscription changes
+// nothing. It returns how many entities were paused.
+func PauseSubscribe(subscribe *Subscribe) (int, error) {
+	return setSubscribeStatus(subscribe, SubscribePaused, OperationUnsubscribe)
+}
+
+// ResumeSubscribe resumes the paused subscription in one transaction, the outbox rows restoring the core
+// subscriptions of its entities and the subscription in their sysField._subscribeAddr are recorded. Resuming an
+// active subscription changes nothing. It returns how many entities were resumed.
+func ResumeSubscribe(subscribe *Subscribe) (int, error) {
+	return setSubscribeStatus(subscribe, SubscribeActive, OperationSubscribe)
+}
+
+func setSubscribeStatus(subscribe *Subscribe, status, operation string) (int, error) {
+	changed := 0
+	err := DB().Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Subscribe{}).Where("id = ? AND status <> ?", subscribe.ID, status).
+			UpdateColumn("status", status)
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "update subscribe status")
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		entityIDs := make([]string, 0)
+		if err := tx.Model(&SubscribeEntities{}).Where("subscribe_id = ?", subscribe.ID).
+			Pluck("entity_id", &entityIDs).Error; err != nil {
+			return errors.Wrap(err, "list subscribed entities")
+		}
+		changed = len(entityIDs)
+		return recordOutbox(tx, subscribe, entityIDs, operation)
+	})
+	if err != nil {
+		return 0, err
+	}
+	subscribe.Status = status
+	log.Infof("subscribe %d is %s, %d entities recorded in outbox", subscribe.ID, status, changed)
+	return changed, nil
+}
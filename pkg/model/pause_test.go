@@ -0,0 +1,94 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
+)
+
+func TestPauseAndResumeSubscribe(t *testing.T) {
+	useTestDB(t)
+	fc := &flakyCore{fakeCore: newFakeCore()}
+	coreClient = fc
+	defer func() { coreClient = nil }()
+	dispatcher := NewOutboxDispatcher()
+	dispatcher.Workers = 1
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	assert.Equal(t, SubscribeActive, sub.Status)
+	other := Subscribe{TenantID: "tenant", UserID: "user", Title: "other"}
+	assert.NoError(t, DB().Create(&other).Error)
+	_, _, err := SubscribeEntitiesInBatches(&sub, []string{"d1", "d2"}, 0, nil)
+	assert.NoError(t, err)
+	_, _, err = SubscribeEntitiesInBatches(&other, []string{"d2"}, 0, nil)
+	assert.NoError(t, err)
+	_, err = dispatcher.Dispatch()
+	assert.NoError(t, err)
+	addr := subscribeuril.NewAddress(sub.ID, AMQPAddressString(sub.Endpoint))
+
+	paused, err := PauseSubscribe(&sub)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, paused)
+	assert.True(t, sub.Paused())
+	paused, err = PauseSubscribe(&sub)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, paused, "a paused subscription is paused once")
+	_, err = dispatcher.Dispatch()
+	assert.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"d1", "d2"}, subscribedEntities(&sub), "the entities are kept")
+	for _, id := range []string{"d1", "d2"} {
+		addrs, err := subscribeuril.ParseAddresses(fc.addrs[id])
+		assert.NoError(t, err)
+		assert.NotContains(t, addrs, addr, id)
+	}
+	var entities []string
+	assert.NoError(t, DB().Model(&CoreSubscription{}).Order("entity_id").Pluck("entity_id", &entities).Error)
+	assert.Equal(t, []string{"d2"}, entities, "d2 is still subscribed by the other subscription")
+	endpoints, err := EntityEndpoints("d2")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{other.Endpoint}, endpoints)
+
+	// the entities subscribed while paused are subscribed in core on resume
+	_, _, err = SubscribeEntitiesInBatches(&sub, []string{"d3"}, 0, nil)
+	assert.NoError(t, err)
+	var pending int64
+	assert.NoError(t, DB().Model(&Outbox{}).Where("status = ?", OutboxPending).Count(&pending).Error)
+	assert.Equal(t, int64(0), pending)
+
+	resumed, err := ResumeSubscribe(&sub)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, resumed)
+	assert.False(t, sub.Paused())
+	_, err = dispatcher.Dispatch()
+	assert.NoError(t, err)
+	for _, id := range []string{"d1", "d2", "d3"} {
+		addrs, err := subscribeuril.ParseAddresses(fc.addrs[id])
+		assert.NoError(t, err)
+		assert.Contains(t, addrs, addr, id)
+	}
+	assert.NoError(t, DB().Model(&CoreSubscription{}).Order("entity_id").Pluck("entity_id", &entities).Error)
+	assert.Equal(t, []string{"d1", "d2", "d3"}, entities)
+}
+
+func TestSubscribeEntitiesOfStalePausedSubscribe(t *testing.T) {
+	useTestDB(t)
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	stale := sub
+	_, err := PauseSubscribe(&sub)
+	assert.NoError(t, err)
+
+	// stale was loaded before the pause, the status read in the transaction applies
+	_, _, err = SubscribeEntitiesInBatches(&stale, []string{"d1"}, 0, nil)
+	assert.NoError(t, err)
+	_, err = UnsubscribeEntities(&stale, []string{"d1"})
+	assert.NoError(t, err)
+	assert.True(t, stale.Paused())
+	var n int64
+	assert.NoError(t, DB().Model(&Outbox{}).Count(&n).Error)
+	assert.Equal(t, int64(0), n)
+}
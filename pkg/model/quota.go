@@ -0,0 +1,211 @@
+package model
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Environment variables of the quotas, a missing or zero quota is unlimited.
+const (
+	quotaSubscribesPerUserEnvKey    = "QUOTA_SUBSCRIBES_PER_USER"
+	quotaEntitiesPerSubscribeEnvKey = "QUOTA_ENTITIES_PER_SUBSCRIBE"
+	quotaEntitiesPerTenantEnvKey    = "QUOTA_ENTITIES_PER_TENANT"
+)
+
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// Quota limits the subscriptions, zero is unlimited.
+type Quota struct {
+	SubscribesPerUser    int64
+	EntitiesPerSubscribe int64
+	// EntitiesPerTenant limits the subscribed entities of all subscriptions of a tenant.
+	EntitiesPerTenant int64
+}
+
+// QuotaUsage is what a user and its tenant use of the Quota.
+type QuotaUsage struct {
+	Subscribes     int64
+	TenantEntities int64
+}
+
+// QuotaProjection is what a subscription and its tenant would use of the Quota once entities are subscribed.
+type QuotaProjection struct {
+	SubscribeEntities int64
+	TenantEntities    int64
+	// Exceeded tells whether subscribing the entities would exceed a quota, as CheckEntitiesQuota does.
+	Exceeded bool
+}
+
+var quota Quota
+
+// LoadQuota reads the quotas from the environment variables.
+func LoadQuota() error {
+	q := Quota{}
+	for key, value := range map[string]*int64{
+		quotaSubscribesPerUserEnvKey:    &q.SubscribesPerUser,
+		quotaEntitiesPerSubscribeEnvKey: &q.EntitiesPerSubscribe,
+		quotaEntitiesPerTenantEnvKey:    &q.EntitiesPerTenant,
+	} {
+		env := os.Getenv(key)
+		if env == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(env, 10, 64)
+		if err != nil || n < 0 {
+			return errors.Errorf("invalid %s %q, want a non-negative integer", key, env)
+		}
+		*value = n
+	}
+	quota = q
+	return nil
+}
+
+// CurrentQuota returns the quotas in use.
+func CurrentQuota() Quota {
+	return quota
+}
+
+// SetQuota replaces the quotas in use.
+func SetQuota(q Quota) {
+	quota = q
+}
+
+// GetQuotaUsage counts the subscriptions of the user and the subscribed entities of its tenant.
+func GetQuotaUsage(tenantID, userID string) (QuotaUsage, error) {
+	usage := QuotaUsage{}
+	if err := DB().Model(&Subscribe{}).Where("tenant_id = ? AND user_id = ?", tenantID, userID).
+		Count(&usage.Subscribes).Error; err != nil {
+		return usage, errors.Wrap(err, "count subscribes of user")
+	}
+	if err := DB().Model(&SubscribeEntities{}).Where("tenant_id = ?", tenantID).
+		Count(&usage.TenantEntities).Error; err != nil {
+		return usage, errors.Wrap(err, "count subscribed entities of tenant")
+	}
+	return usage, nil
+}
+
+// CheckSubscribeQuota returns ErrQuotaExceeded when the user may not create another subscription.
+func CheckSubscribeQuota(tenantID, userID string) error {
+	if quota.SubscribesPerUser == 0 {
+		return nil
+	}
+	var count int64
+	if err := DB().Model(&Subscribe{}).Where("tenant_id = ? AND user_id = ?", tenantID, userID).
+		Count(&count).Error; err != nil {
+		return errors.Wrap(err, "count subscribes of user")
+	}
+	if count >= quota.SubscribesPerUser {
+		return errors.Wrapf(ErrQuotaExceeded, "user has %d of %d subscribes", count, quota.SubscribesPerUser)
+	}
+	return nil
+}
+
+// CheckEntitiesQuota returns ErrQuotaExceeded when subscribing the entities would exceed
+// the entities quota of the subscription or of its tenant. The entities already subscribed
+// do not count. The check runs before the entities are subscribed, so concurrent requests
+// may exceed the quotas by the size of one request.
+func CheckEntitiesQuota(subscribe *Subscribe, entityIDs []string) error {
+	return checkEntitiesQuota(subscribe, entityIDs, true)
+}
+
+// CheckMovedEntitiesQuota is CheckEntitiesQuota for the entities moved to the subscription from
+// another subscription of its tenant, only the entities quota of the subscription applies since
+// the tenant keeps as many subscribed entities.
+func CheckMovedEntitiesQuota(subscribe *Subscribe, entityIDs []string) error {
+	return checkEntitiesQuota(subscribe, entityIDs, false)
+}
+
+func checkEntitiesQuota(subscribe *Subscribe, entityIDs []string, tenant bool) error {
+	if quota.EntitiesPerSubscribe == 0 && (quota.EntitiesPerTenant == 0 || !tenant) {
+		return nil
+	}
+	added, err := countUnsubscribed(subscribe.ID, uniqueStrings(entityIDs))
+	if err != nil {
+		return err
+	}
+	if added == 0 {
+		return nil
+	}
+
+	if quota.EntitiesPerSubscribe > 0 {
+		var count int64
+		if err = DB().Model(&SubscribeEntities{}).Where("subscribe_id = ?", subscribe.ID).
+			Count(&count).Error; err != nil {
+			return errors.Wrap(err, "count subscribed entities of subscribe")
+		}
+		if count+added > quota.EntitiesPerSubscribe {
+			return errors.Wrapf(ErrQuotaExceeded, "subscribe has %d of %d entities, %d more requested",
+				count, quota.EntitiesPerSubscribe, added)
+		}
+	}
+	if quota.EntitiesPerTenant > 0 && tenant {
+		var count int64
+		if err = DB().Model(&SubscribeEntities{}).Where("tenant_id = ?", subscribe.TenantID).
+			Count(&count).Error; err != nil {
+			return errors.Wrap(err, "count subscribed entities of tenant")
+		}
+		if count+added > quota.EntitiesPerTenant {
+			return errors.Wrapf(ErrQuotaExceeded, "tenant has %d of %d entities, %d more requested",
+				count, quota.EntitiesPerTenant, added)
+		}
+	}
+	return nil
+}
+
+// ProjectEntitiesQuota returns the entities the subscription and its tenant would have once the entities are
+// subscribed, the entities already subscribed do not count.
+func ProjectEntitiesQuota(subscribe *Subscribe, entityIDs []string) (QuotaProjection, error) {
+	return projectEntitiesQuota(subscribe, entityIDs, true)
+}
+
+// ProjectMovedEntitiesQuota is ProjectEntitiesQuota for the entities moved to the subscription from another
+// subscription of its tenant, the tenant keeps as many subscribed entities and only the entities quota of the
+// subscription is exceeded, as CheckMovedEntitiesQuota does.
+func ProjectMovedEntitiesQuota(subscribe *Subscribe, entityIDs []string) (QuotaProjection, error) {
+	return projectEntitiesQuota(subscribe, entityIDs, false)
+}
+
+func projectEntitiesQuota(subscribe *Subscribe, entityIDs []string, tenant bool) (QuotaProjection, error) {
+	projection := QuotaProjection{}
+	added, err := countUnsubscribed(subscribe.ID, uniqueStrings(entityIDs))
+	if err != nil {
+		return projection, err
+	}
+	if err = DB().Model(&SubscribeEntities{}).Where("subscribe_id = ?", subscribe.ID).
+		Count(&projection.SubscribeEntities).Error; err != nil {
+		return projection, errors.Wrap(err, "count subscribed entities of subscribe")
+	}
+	if err = DB().Model(&SubscribeEntities{}).Where("tenant_id = ?", subscribe.TenantID).
+		Count(&projection.TenantEntities).Error; err != nil {
+		return projection, errors.Wrap(err, "count subscribed entities of tenant")
+	}
+	projection.SubscribeEntities += added
+	if tenant {
+		projection.TenantEntities += added
+	}
+	projection.Exceeded = added > 0 &&
+		(quota.EntitiesPerSubscribe > 0 && projection.SubscribeEntities > quota.EntitiesPerSubscribe ||
+			tenant && quota.EntitiesPerTenant > 0 && projection.TenantEntities > quota.EntitiesPerTenant)
+	return projection, nil
+}
+
+// countUnsubscribed counts the entities which are not subscribed by the subscription yet.
+func countUnsubscribed(subscribeID uint, entityIDs []string) (int64, error) {
+	added := int64(len(entityIDs))
+	for start := 0; start < len(entityIDs); start += DefaultBulkBatchSize {
+		end := start + DefaultBulkBatchSize
+		if end > len(entityIDs) {
+			end = len(entityIDs)
+		}
+		var existing int64
+		if err := DB().Model(&SubscribeEntities{}).
+			Where("subscribe_id = ? AND entity_id IN ?", subscribeID, entityIDs[start:end]).
+			Count(&existing).Error; err != nil {
+			return 0, errors.Wrap(err, "count subscribed entities")
+		}
+		added -= existing
+	}
+	return added, nil
+}
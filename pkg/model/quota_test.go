@@ -0,0 +1,120 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadQuota(t *testing.T) {
+	t.Cleanup(func() { SetQuota(Quota{}) })
+
+	t.Setenv(quotaSubscribesPerUserEnvKey, "3")
+	t.Setenv(quotaEntitiesPerTenantEnvKey, "100")
+	assert.NoError(t, LoadQuota())
+	assert.Equal(t, Quota{SubscribesPerUser: 3, EntitiesPerTenant: 100}, CurrentQuota())
+
+	t.Setenv(quotaEntitiesPerSubscribeEnvKey, "-1")
+	assert.Error(t, LoadQuota())
+}
+
+func TestCheckQuota(t *testing.T) {
+	useTestDB(t)
+	t.Cleanup(func() { SetQuota(Quota{}) })
+	SetQuota(Quota{SubscribesPerUser: 2, EntitiesPerSubscribe: 3, EntitiesPerTenant: 4})
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, CheckSubscribeQuota("tenant", "user"))
+	assert.NoError(t, DB().Create(&sub).Error)
+	other := Subscribe{TenantID: "tenant", UserID: "user", Title: "other"}
+	assert.NoError(t, DB().Create(&other).Error)
+	assert.True(t, errors.Is(CheckSubscribeQuota("tenant", "user"), ErrQuotaExceeded))
+	assert.NoError(t, CheckSubscribeQuota("tenant", "another"))
+
+	_, _, err := SubscribeEntitiesInBatches(&sub, []string{"d1", "d2"}, 0, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, CheckEntitiesQuota(&sub, []string{"d1", "d2", "d3"}), "subscribed entities do not count")
+	assert.True(t, errors.Is(CheckEntitiesQuota(&sub, []string{"d3", "d4"}), ErrQuotaExceeded), "entities per subscribe")
+
+	_, _, err = SubscribeEntitiesInBatches(&other, []string{"d1", "d2"}, 0, nil)
+	assert.NoError(t, err)
+	assert.True(t, errors.Is(CheckEntitiesQuota(&other, []string{"d3"}), ErrQuotaExceeded), "entities per tenant")
+
+	usage, err := GetQuotaUsage("tenant", "user")
+	assert.NoError(t, err)
+	assert.Equal(t, QuotaUsage{Subscribes: 2, TenantEntities: 4}, usage)
+}
+
+func TestProjectEntitiesQuota(t *testing.T) {
+	useTestDB(t)
+	t.Cleanup(func() { SetQuota(Quota{}) })
+	SetQuota(Quota{EntitiesPerSubscribe: 3, EntitiesPerTenant: 4})
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	other := Subscribe{TenantID: "tenant", UserID: "user", Title: "other"}
+	assert.NoError(t, DB().Create(&other).Error)
+	_, _, err := SubscribeEntitiesInBatches(&sub, []string{"d1"}, 0, nil)
+	assert.NoError(t, err)
+	_, _, err = SubscribeEntitiesInBatches(&other, []string{"d1", "d2"}, 0, nil)
+	assert.NoError(t, err)
+
+	projection, err := ProjectEntitiesQuota(&sub, []string{"d1", "d2"})
+	assert.NoError(t, err)
+	assert.Equal(t, QuotaProjection{SubscribeEntities: 2, TenantEntities: 4}, projection)
+
+	projection, err = ProjectEntitiesQuota(&sub, []string{"d2", "d3"})
+	assert.NoError(t, err)
+	assert.Equal(t, QuotaProjection{SubscribeEntities: 3, TenantEntities: 5, Exceeded: true}, projection)
+	assert.True(t, errors.Is(CheckEntitiesQuota(&sub, []string{"d2", "d3"}), ErrQuotaExceeded), "as CheckEntitiesQuota")
+}
+
+func TestCheckMovedEntitiesQuota(t *testing.T) {
+	useTestDB(t)
+	t.Cleanup(func() { SetQuota(Quota{}) })
+	SetQuota(Quota{EntitiesPerSubscribe: 2, EntitiesPerTenant: 3})
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	target := Subscribe{TenantID: "tenant", UserID: "user", Title: "target"}
+	assert.NoError(t, DB().Create(&target).Error)
+	_, _, err := SubscribeEntitiesInBatches(&sub, []string{"d1"}, 0, nil)
+	assert.NoError(t, err)
+	_, _, err = SubscribeEntitiesInBatches(&target, []string{"d2", "d3"}, 0, nil)
+	assert.NoError(t, err)
+
+	assert.True(t, errors.Is(CheckMovedEntitiesQuota(&target, []string{"d1"}), ErrQuotaExceeded), "target at its limit")
+	assert.NoError(t, CheckMovedEntitiesQuota(&target, []string{"d2"}), "subscribed entities do not count")
+
+	// the tenant is at its limit, a move keeps its entities
+	assert.True(t, errors.Is(CheckEntitiesQuota(&sub, []string{"d2"}), ErrQuotaExceeded))
+	assert.NoError(t, CheckMovedEntitiesQuota(&sub, []string{"d2"}))
+}
+
+func TestProjectMovedEntitiesQuota(t *testing.T) {
+	useTestDB(t)
+	t.Cleanup(func() { SetQuota(Quota{}) })
+	SetQuota(Quota{EntitiesPerSubscribe: 3, EntitiesPerTenant: 3})
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	target := Subscribe{TenantID: "tenant", UserID: "user", Title: "target"}
+	assert.NoError(t, DB().Create(&target).Error)
+	_, _, err := SubscribeEntitiesInBatches(&sub, []string{"d1", "d2"}, 0, nil)
+	assert.NoError(t, err)
+	_, _, err = SubscribeEntitiesInBatches(&target, []string{"d3"}, 0, nil)
+	assert.NoError(t, err)
+
+	// the tenant is at its limit, a move keeps its entities
+	projection, err := ProjectMovedEntitiesQuota(&target, []string{"d1", "d2"})
+	assert.NoError(t, err)
+	assert.Equal(t, QuotaProjection{SubscribeEntities: 3, TenantEntities: 3}, projection)
+	assert.NoError(t, CheckMovedEntitiesQuota(&target, []string{"d1", "d2"}), "as CheckMovedEntitiesQuota")
+
+	SetQuota(Quota{EntitiesPerSubscribe: 2, EntitiesPerTenant: 3})
+	projection, err = ProjectMovedEntitiesQuota(&target, []string{"d1", "d2"})
+	assert.NoError(t, err)
+	assert.Equal(t, QuotaProjection{SubscribeEntities: 3, TenantEntities: 3, Exceeded: true}, projection)
+	assert.True(t, errors.Is(CheckMovedEntitiesQuota(&target, []string{"d1", "d2"}), ErrQuotaExceeded), "as CheckMovedEntitiesQuota")
+}
@@ -0,0 +1,166 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	defaultRedeliverInterval = 5 * time.Second
+	defaultRedeliverBatch    = 100
+)
+
+// Redelivery is an event fanned out to an endpoint which failed to receive it, the Redeliverer publishes
+// it again to that endpoint only, so the endpoints which received the event do not receive it twice.
+type Redelivery struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	EntityID  string `gorm:"index;size:255;not null"`
+	Endpoint  string `gorm:"size:255;not null"`
+	// Data is the event encoded in JSON.
+	Data      string `gorm:"type:text;not null"`
+	Attempts  int
+	LastError string    `gorm:"size:1024"`
+	NextRunAt time.Time `gorm:"index"`
+}
+
+// recordRedelivery records the event of the entity which failed to be published to the endpoint.
+func recordRedelivery(entityID, endpoint string, data interface{}, publishErr error) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "encode event")
+	}
+	r := &Redelivery{
+		EntityID:  entityID,
+		Endpoint:  endpoint,
+		Data:      string(encoded),
+		Attempts:  1,
+		LastError: truncate(publishErr.Error(), 1024),
+		NextRunAt: time.Now().Add(retryBackoff(1)),
+	}
+	return errors.Wrap(DB().Create(r).Error, "record redelivery")
+}
+
+// Redeliverer publishes the due redeliveries again every Interval, with a backoff between the attempts.
+// A redelivery is claimed before it is published, so the redeliverers of several replicas never publish it
+// twice, and is dropped once published, after MaxAttempts or when its endpoint no longer subscribes the entity.
+// The redelivered events may reach the endpoint after the later events of the entity.
+type Redeliverer struct {
+	Interval     time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	ClaimTimeout time.Duration
+}
+
+func NewRedeliverer() *Redeliverer {
+	return &Redeliverer{
+		Interval:     defaultRedeliverInterval,
+		BatchSize:    defaultRedeliverBatch,
+		MaxAttempts:  defaultMaxAttempts,
+		ClaimTimeout: defaultClaimTimeout,
+	}
+}
+
+// Run redelivers until ctx is done.
+func (r *Redeliverer) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := r.Redeliver(); err != nil {
+				log.Error("redeliver events err:", err)
+			}
+		}
+	}
+}
+
+// Redeliver publishes one batch of due redeliveries and returns how many were published.
+func (r *Redeliverer) Redeliver() (int, error) {
+	due := make([]*Redelivery, 0)
+	if err := DB().Where("next_run_at <= ?", time.Now()).Order("id").Limit(r.BatchSize).Find(&due).Error; err != nil {
+		return 0, errors.Wrap(err, "list due redeliveries")
+	}
+	published := 0
+	for _, rd := range due {
+		claimed, err := r.claim(rd)
+		if err != nil {
+			return published, err
+		}
+		if !claimed {
+			continue
+		}
+		ok, err := r.redeliverOne(rd)
+		if err != nil {
+			log.Errorf("save redelivery %d err: %v", rd.ID, err)
+			continue
+		}
+		if ok {
+			published++
+		}
+	}
+	return published, nil
+}
+
+// claim postpones the due redelivery rd until the claim expires, it returns false when another redeliverer
+// has claimed it meanwhile. As for the outbox, the expiry is kept in rd.NextRunAt and truncated to the second.
+func (r *Redeliverer) claim(rd *Redelivery) (bool, error) {
+	expires := time.Now().Add(r.ClaimTimeout).Truncate(time.Second)
+	result := DB().Model(&Redelivery{}).Where("id = ? AND next_run_at = ?", rd.ID, rd.NextRunAt).
+		UpdateColumn("next_run_at", expires)
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "claim redelivery")
+	}
+	rd.NextRunAt = expires
+	return result.RowsAffected == 1, nil
+}
+
+// redeliverOne publishes the claimed redelivery rd and returns whether it was published, the redelivery is
+// then dropped, otherwise its next attempt is saved.
+func (r *Redeliverer) redeliverOne(rd *Redelivery) (bool, error) {
+	claimed := DB().Where("id = ? AND next_run_at = ?", rd.ID, rd.NextRunAt)
+	endpoints, err := EntityEndpoints(rd.EntityID)
+	if err != nil {
+		return false, err
+	}
+	if !containsString(endpoints, rd.Endpoint) {
+		log.Infof("drop redelivery %d, %s no longer subscribes entity %s", rd.ID, rd.Endpoint, rd.EntityID)
+		return false, errors.Wrap(claimed.Delete(&Redelivery{}).Error, "drop redelivery")
+	}
+
+	var data interface{}
+	if err = json.Unmarshal([]byte(rd.Data), &data); err == nil {
+		err = coreClient.Publish(rd.Endpoint, data)
+	}
+	if err == nil {
+		return true, errors.Wrap(claimed.Delete(&Redelivery{}).Error, "drop redelivery")
+	}
+	rd.Attempts++
+	log.Errorf("redeliver event of entity %s to %s attempt %d err: %v", rd.EntityID, rd.Endpoint, rd.Attempts, err)
+	if rd.Attempts >= r.MaxAttempts {
+		log.Errorf("drop redelivery %d after %d attempts, %s misses the event of entity %s",
+			rd.ID, rd.Attempts, rd.Endpoint, rd.EntityID)
+		return false, errors.Wrap(claimed.Delete(&Redelivery{}).Error, "drop redelivery")
+	}
+	err = claimed.Model(&Redelivery{}).UpdateColumns(map[string]interface{}{
+		"attempts":    rd.Attempts,
+		"last_error":  truncate(err.Error(), 1024),
+		"next_run_at": time.Now().Add(retryBackoff(rd.Attempts)),
+	}).Error
+	return false, errors.Wrap(err, "save redelivery")
+}
+
+func containsString(items []string, s string) bool {
+	for _, item := range items {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
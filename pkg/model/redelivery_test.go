@@ -0,0 +1,90 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedeliver(t *testing.T) {
+	useTestDB(t)
+	fc := &flakyCore{fakeCore: newFakeCore()}
+	coreClient = fc
+	defer func() { coreClient = nil }()
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	other := Subscribe{TenantID: "tenant", UserID: "user", Title: "other"}
+	assert.NoError(t, DB().Create(&other).Error)
+	_, _, err := SubscribeEntitiesInBatches(&sub, []string{"device"}, 0, nil)
+	assert.NoError(t, err)
+	_, _, err = SubscribeEntitiesInBatches(&other, []string{"device"}, 0, nil)
+	assert.NoError(t, err)
+	_, err = NewOutboxDispatcher().Dispatch()
+	assert.NoError(t, err)
+
+	fc.publishErrs = map[string]error{sub.Endpoint: errors.New("endpoint unavailable")}
+	_, err = Fanout(coreSubscriptionID("device"), map[string]interface{}{"id": "device"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{other.Endpoint}, fc.published)
+	due := func() {
+		assert.NoError(t, DB().Model(&Redelivery{}).Where("1 = 1").
+			UpdateColumn("next_run_at", time.Now().Add(-time.Second)).Error)
+	}
+
+	redeliverer := NewRedeliverer()
+	published, err := redeliverer.Redeliver()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, published, "not due yet")
+
+	due()
+	published, err = redeliverer.Redeliver()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, published)
+	got := Redelivery{}
+	assert.NoError(t, DB().First(&got).Error)
+	assert.Equal(t, 2, got.Attempts)
+	assert.True(t, got.NextRunAt.After(time.Now()), "backing off")
+
+	fc.publishErrs = nil
+	due()
+	published, err = redeliverer.Redeliver()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, published)
+	assert.Equal(t, []string{other.Endpoint, sub.Endpoint}, fc.published, "only the failed endpoint receives it again")
+	var n int64
+	assert.NoError(t, DB().Model(&Redelivery{}).Count(&n).Error)
+	assert.Equal(t, int64(0), n)
+}
+
+func TestRedeliverDropped(t *testing.T) {
+	useTestDB(t)
+	fc := &flakyCore{fakeCore: newFakeCore()}
+	coreClient = fc
+	defer func() { coreClient = nil }()
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	_, _, err := SubscribeEntitiesInBatches(&sub, []string{"d1"}, 0, nil)
+	assert.NoError(t, err)
+	unavailable := errors.New("endpoint unavailable")
+	fc.publishErrs = map[string]error{sub.Endpoint: unavailable}
+	assert.NoError(t, recordRedelivery("d1", sub.Endpoint, map[string]interface{}{"id": "d1"}, unavailable))
+	assert.NoError(t, recordRedelivery("d2", sub.Endpoint, map[string]interface{}{"id": "d2"}, unavailable))
+	assert.NoError(t, DB().Model(&Redelivery{}).Where("1 = 1").
+		UpdateColumn("next_run_at", time.Now().Add(-time.Second)).Error)
+
+	redeliverer := NewRedeliverer()
+	redeliverer.MaxAttempts = 2
+	published, err := redeliverer.Redeliver()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, published)
+
+	// d1 failed its last attempt, d2 is not subscribed by the endpoint
+	var n int64
+	assert.NoError(t, DB().Model(&Redelivery{}).Count(&n).Error)
+	assert.Equal(t, int64(0), n)
+	assert.Empty(t, fc.published)
+}
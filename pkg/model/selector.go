@@ -0,0 +1,243 @@
+package model
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// Kinds of a Selector.
+const (
+	SelectorGroup      = "group"
+	SelectorTemplate   = "template"
+	SelectorConditions = "conditions"
+)
+
+var ErrInvalidSelector = errors.New("invalid selector")
+
+// Selector keeps a subscription in sync with the devices it selects: a group, optionally with its subgroups,
+// a template, or the search conditions encoded in Conditions.
+type Selector struct {
+	ID               uint `gorm:"primarykey"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	TenantID         string `gorm:"index;not null;default:''"`
+	SubscribeID      uint   `gorm:"index;not null"`
+	Kind             string `gorm:"size:32;not null"`
+	Value            string `gorm:"size:255"`
+	IncludeSubgroups bool
+	Conditions       string `gorm:"type:text"`
+	LastSyncedAt     *time.Time
+	LastError        string `gorm:"size:1024"`
+}
+
+// SelectorEntity is an entity subscribed because a Selector selects it.
+type SelectorEntity struct {
+	ID          uint   `gorm:"primarykey"`
+	SelectorID  uint   `gorm:"uniqueIndex:idx_selector_entity;not null"`
+	SubscribeID uint   `gorm:"index;not null"`
+	EntityID    string `gorm:"uniqueIndex:idx_selector_entity;size:255;not null"`
+}
+
+// SelectorSync reports the entities a sync of a Selector has changed.
+type SelectorSync struct {
+	Attached []string
+	Detached []string
+}
+
+// Validate returns ErrInvalidSelector when the selector selects nothing.
+func (s *Selector) Validate() error {
+	switch s.Kind {
+	case SelectorGroup, SelectorTemplate:
+		if s.Value == "" {
+			return errors.Wrapf(ErrInvalidSelector, "%s selector without value", s.Kind)
+		}
+	case SelectorConditions:
+		if s.Conditions == "" {
+			return errors.Wrap(ErrInvalidSelector, "conditions selector without conditions")
+		}
+	default:
+		return errors.Wrapf(ErrInvalidSelector, "unknown kind %q", s.Kind)
+	}
+	return nil
+}
+
+// ListSelectors returns the selectors of the subscription.
+func ListSelectors(subscribeID uint) ([]Selector, error) {
+	selectors := make([]Selector, 0)
+	err := DB().Where("subscribe_id = ?", subscribeID).Order("id").Find(&selectors).Error
+	return selectors, errors.Wrap(err, "list selectors")
+}
+
+// ActiveSelectors returns the selectors of the subscriptions which are not deleted.
+func ActiveSelectors() ([]Selector, error) {
+	selectors := make([]Selector, 0)
+	err := DB().Where("subscribe_id IN (?)", DB().Model(&Subscribe{}).Select("id")).Order("id").Find(&selectors).Error
+	return selectors, errors.Wrap(err, "list active selectors")
+}
+
+// SelectorEntityCounts returns how many entities every selector of the subscription holds.
+func SelectorEntityCounts(subscribeID uint) (map[uint]int64, error) {
+	rows := make([]struct {
+		SelectorID uint
+		Count      int64
+	}, 0)
+	if err := DB().Model(&SelectorEntity{}).Select("selector_id, count(*) AS count").
+		Where("subscribe_id = ?", subscribeID).Group("selector_id").Scan(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "count selector entities")
+	}
+	counts := make(map[uint]int64, len(rows))
+	for _, row := range rows {
+		counts[row.SelectorID] = row.Count
+	}
+	return counts, nil
+}
+
+// SyncSelector makes the subscription of the selector hold the selected entities, in one transaction.
+// Selected entities are attached, and the entities the selector held before but does not select anymore
+// are detached unless another selector of the subscription still holds them. Entities subscribed by hand
+// are never detached.
+func SyncSelector(selector *Selector, selected []string) (SelectorSync, error) {
+	result := SelectorSync{Attached: []string{}, Detached: []string{}}
+	err := DB().Transaction(func(tx *gorm.DB) error {
+		subscribe := &Subscribe{}
+		if err := tx.Where("id = ?", selector.SubscribeID).First(subscribe).Error; err != nil {
+			return errors.Wrap(err, "query subscribe of selector")
+		}
+		held := make([]string, 0)
+		if err := tx.Model(&SelectorEntity{}).Where("selector_id = ?", selector.ID).
+			Pluck("entity_id", &held).Error; err != nil {
+			return errors.Wrap(err, "list selector entities")
+		}
+		toAttach, toDetach := diffStrings(uniqueStrings(selected), held)
+
+		for _, batch := range chunkStrings(toAttach, DefaultBulkBatchSize) {
+			attached, err := attachSelected(tx, selector, subscribe, batch)
+			if err != nil {
+				return err
+			}
+			result.Attached = append(result.Attached, attached...)
+		}
+		for _, batch := range chunkStrings(toDetach, DefaultBulkBatchSize) {
+			detached, err := detachUnselected(tx, selector, subscribe, batch)
+			if err != nil {
+				return err
+			}
+			result.Detached = append(result.Detached, detached...)
+		}
+
+		now := time.Now()
+		selector.LastSyncedAt, selector.LastError = &now, ""
+		return errors.Wrap(tx.Model(selector).UpdateColumns(map[string]interface{}{
+			"last_synced_at": now,
+			"last_error":     "",
+		}).Error, "update selector")
+	})
+	return result, err
+}
+
+// attachSelected subscribes the entities newly selected by the selector and returns those it subscribed.
+// The selector shares the entities another selector of the subscription holds, not those subscribed by hand.
+func attachSelected(tx *gorm.DB, selector *Selector, subscribe *Subscribe, entityIDs []string) ([]string, error) {
+	created, err := subscribeBatch(tx, subscribe, entityIDs)
+	if err != nil {
+		return nil, err
+	}
+	existing, _ := diffStrings(entityIDs, created)
+	shared := make([]string, 0)
+	if len(existing) != 0 {
+		if err = tx.Model(&SelectorEntity{}).Distinct("entity_id").
+			Where("subscribe_id = ? AND entity_id IN ?", subscribe.ID, existing).
+			Pluck("entity_id", &shared).Error; err != nil {
+			return nil, errors.Wrap(err, "query entities held by selectors")
+		}
+	}
+
+	records := make([]SelectorEntity, 0, len(created)+len(shared))
+	for _, id := range append(created, shared...) {
+		records = append(records, SelectorEntity{SelectorID: selector.ID, SubscribeID: subscribe.ID, EntityID: id})
+	}
+	if len(records) != 0 {
+		if err = tx.Session(&gorm.Session{NewDB: true}).Create(&records).Error; err != nil {
+			return nil, errors.Wrap(err, "record selector entities")
+		}
+	}
+	return created, nil
+}
+
+// detachUnselected releases the entities the selector does not select anymore and unsubscribes
+// those no other selector of the subscription holds, it returns the unsubscribed entities.
+func detachUnselected(tx *gorm.DB, selector *Selector, subscribe *Subscribe, entityIDs []string) ([]string, error) {
+	if err := tx.Where("selector_id = ? AND entity_id IN ?", selector.ID, entityIDs).
+		Delete(&SelectorEntity{}).Error; err != nil {
+		return nil, errors.Wrap(err, "release selector entities")
+	}
+	stillHeld := make([]string, 0)
+	if err := tx.Model(&SelectorEntity{}).Distinct("entity_id").
+		Where("subscribe_id = ? AND entity_id IN ?", subscribe.ID, entityIDs).
+		Pluck("entity_id", &stillHeld).Error; err != nil {
+		return nil, errors.Wrap(err, "query entities held by selectors")
+	}
+	released, _ := diffStrings(entityIDs, stillHeld)
+	if len(released) == 0 {
+		return released, nil
+	}
+	return unsubscribeBatch(tx, subscribe, released)
+}
+
+// RecordSelectorError keeps the error of the last sync of the selector.
+func RecordSelectorError(selector *Selector, syncErr error) error {
+	now := time.Now()
+	selector.LastSyncedAt, selector.LastError = &now, truncate(syncErr.Error(), 1024)
+	return errors.Wrap(DB().Model(selector).UpdateColumns(map[string]interface{}{
+		"last_synced_at": now,
+		"last_error":     selector.LastError,
+	}).Error, "update selector")
+}
+
+// DeleteSelector detaches the entities the selector holds, as SyncSelector does when it selects nothing,
+// and deletes it.
+func DeleteSelector(selector *Selector) (SelectorSync, error) {
+	result, err := SyncSelector(selector, nil)
+	if err != nil {
+		return result, err
+	}
+	return result, errors.Wrap(DB().Delete(selector).Error, "delete selector")
+}
+
+// diffStrings returns the items of a missing from b and the items of b missing from a.
+func diffStrings(a, b []string) (onlyA, onlyB []string) {
+	inA := make(map[string]bool, len(a))
+	for _, item := range a {
+		inA[item] = true
+	}
+	inB := make(map[string]bool, len(b))
+	for _, item := range b {
+		inB[item] = true
+	}
+	onlyA, onlyB = make([]string, 0), make([]string, 0)
+	for _, item := range a {
+		if !inB[item] {
+			onlyA = append(onlyA, item)
+		}
+	}
+	for _, item := range b {
+		if !inA[item] {
+			onlyB = append(onlyB, item)
+		}
+	}
+	return onlyA, onlyB
+}
+
+func chunkStrings(items []string, size int) [][]string {
+	chunks := make([][]string, 0, len(items)/size+1)
+	for start := 0; start < len(items); start += size {
+		end := start + size
+		if end > len(items) {
+			end = len(items)
+		}
+		chunks = append(chunks, items[start:end])
+	}
+	return chunks
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectorValidate(t *testing.T) {
+	tests := []struct {
+		selector Selector
+		excepted bool
+	}{
+		{Selector{Kind: SelectorGroup, Value: "g1"}, true},
+		{Selector{Kind: SelectorGroup}, false},
+		{Selector{Kind: SelectorTemplate, Value: "t1"}, true},
+		{Selector{Kind: SelectorConditions, Conditions: `[{"field":"a","operator":"$eq","value":"b"}]`}, true},
+		{Selector{Kind: SelectorConditions}, false},
+		{Selector{Kind: "model", Value: "m1"}, false},
+	}
+	for _, test := range tests {
+		err := test.selector.Validate()
+		assert.Equal(t, test.excepted, err == nil, test.selector.Kind)
+		if err != nil {
+			assert.True(t, errors.Is(err, ErrInvalidSelector))
+		}
+	}
+}
+
+func TestSyncSelector(t *testing.T) {
+	useTestDB(t)
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	_, _, err := SubscribeEntitiesInBatches(&sub, []string{"manual"}, 0, nil)
+	assert.NoError(t, err)
+	group := Selector{TenantID: "tenant", SubscribeID: sub.ID, Kind: SelectorGroup, Value: "g1"}
+	assert.NoError(t, DB().Create(&group).Error)
+	template := Selector{TenantID: "tenant", SubscribeID: sub.ID, Kind: SelectorTemplate, Value: "t1"}
+	assert.NoError(t, DB().Create(&template).Error)
+
+	result, err := SyncSelector(&group, []string{"manual", "d1", "d2", "d2"})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"d1", "d2"}, result.Attached)
+	assert.Empty(t, result.Detached)
+	assert.NotNil(t, group.LastSyncedAt)
+
+	result, err = SyncSelector(&template, []string{"d2", "d3"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"d3"}, result.Attached)
+
+	result, err = SyncSelector(&group, []string{"d1"})
+	assert.NoError(t, err)
+	assert.Empty(t, result.Attached)
+	assert.Empty(t, result.Detached, "d2 is held by the template selector")
+
+	result, err = SyncSelector(&template, nil)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"d2", "d3"}, result.Detached)
+
+	result, err = DeleteSelector(&group)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"d1"}, result.Detached)
+
+	ids := make([]string, 0)
+	DB().Model(&SubscribeEntities{}).Where("subscribe_id = ?", sub.ID).Pluck("entity_id", &ids)
+	assert.Equal(t, []string{"manual"}, ids, "entities subscribed by hand are kept")
+
+	counts, err := SelectorEntityCounts(sub.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, counts)
+	selectors, err := ListSelectors(sub.ID)
+	assert.NoError(t, err)
+	assert.Len(t, selectors, 1)
+}
+
+func TestActiveSelectors(t *testing.T) {
+	useTestDB(t)
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	deleted := Subscribe{TenantID: "tenant", UserID: "user", Title: "deleted"}
+	assert.NoError(t, DB().Create(&deleted).Error)
+	assert.NoError(t, DB().Create(&Selector{TenantID: "tenant", SubscribeID: sub.ID, Kind: SelectorGroup, Value: "g1"}).Error)
+	assert.NoError(t, DB().Create(&Selector{TenantID: "tenant", SubscribeID: deleted.ID, Kind: SelectorGroup, Value: "g1"}).Error)
+	assert.NoError(t, DB().Delete(&deleted).Error)
+
+	selectors, err := ActiveSelectors()
+	assert.NoError(t, err)
+	assert.Len(t, selectors, 1)
+	assert.Equal(t, sub.ID, selectors[0].SubscribeID)
+}
+
+func TestUnsubscribeReleasesSelectorEntities(t *testing.T) {
+	useTestDB(t)
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	group := Selector{TenantID: "tenant", SubscribeID: sub.ID, Kind: SelectorGroup, Value: "g1"}
+	assert.NoError(t, DB().Create(&group).Error)
+	_, err := SyncSelector(&group, []string{"d1", "d2"})
+	assert.NoError(t, err)
+
+	_, err = UnsubscribeEntities(&sub, []string{"d1"})
+	assert.NoError(t, err)
+	result, err := SyncSelector(&group, []string{"d1", "d2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"d1"}, result.Attached, "the selector attaches the unsubscribed entity again")
+}
@@ -3,64 +3,61 @@ package model
 import (
 	"crypto/md5"
 	"encoding/hex"
-	"strconv"
-	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
 	"github.com/tkeel-io/core-broker/pkg/util"
 	"github.com/tkeel-io/kit/log"
 	"gorm.io/gorm"
 )
 
-var ErrUndeleteable = errors.New("undeleteable")
+var (
+	ErrUndeleteable           = errors.New("undeleteable")
+	ErrConcurrentModification = errors.New("concurrent modification")
+	ErrSubscribeMismatch      = errors.New("subscribe and user ID mismatch")
+)
 
 type Subscribe struct {
 	gorm.Model
 	Title       string `gorm:"not null"`
 	Description string
+	TenantID    string `gorm:"index;not null;default:''"`
 	UserID      string `gorm:"index"`
 	Endpoint    string `gorm:"index"`
 	IsDefault   bool   `gorm:"default:false"`
+	// DefaultOwner is only set on default subscriptions, its unique index keeps one default subscription per user.
+	DefaultOwner *string `gorm:"uniqueIndex;size:255"`
+	// Managed subscriptions are applied from the manifest file Manifest, see ApplyManifestSubscribe,
+	// they are not changed through the API.
+	Managed  bool   `gorm:"default:false"`
+	Manifest string `gorm:"index;size:255"`
+	// Status is SubscribeActive or SubscribePaused, the entities of a paused subscription are kept
+	// but not subscribed in core, see PauseSubscribe.
+	Status string `gorm:"index;size:32;not null;default:'active'"`
 }
 
 func (s *Subscribe) BeforeCreate(tx *gorm.DB) error {
 	if s.Endpoint == "" {
 		s.Endpoint = util.GenerateSubscribeEndpoint()
 	}
+	if s.Status == "" {
+		s.Status = SubscribeActive
+	}
+	if s.IsDefault && s.DefaultOwner == nil {
+		s.DefaultOwner = defaultOwner(s.TenantID, s.UserID)
+	}
 	return nil
 }
 
-func (s *Subscribe) UpdateEndpointTitle(oldTitle, newTitle string) error {
-	subEntities := make([]*SubscribeEntities, 0)
-	res := DB().Model(&SubscribeEntities{}).
-		Where(&SubscribeEntities{
-			SubscribeID: s.ID,
-		}).Find(&subEntities)
-	log.Info(res)
-	if res.Error != nil {
-		return nil
+// FindUserSubscribe loads the subscription id of the user of the tenant into subscribe. It fails with
+// ErrSubscribeMismatch when the user has no such subscription.
+func FindUserSubscribe(subscribe *Subscribe, tenantID, userID string, id uint) error {
+	err := DB().Where("id = ? AND tenant_id = ? AND user_id = ?", id, tenantID, userID).First(subscribe).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.Wrapf(ErrSubscribeMismatch, "subscribe %d", id)
 	}
-	for _, e := range subEntities {
-		log.Info(e, oldTitle, newTitle)
-		subscribe := Subscribe{}
-		DB().Model(&subscribe).Where("id = ?", e.SubscribeID).First(&subscribe)
-		e.Subscribe = subscribe
-		if err := updateEntitySubscribeEndpoint(e.EntityID,
-			strings.Join([]string{oldTitle, strconv.FormatUint(uint64(e.SubscribeID), 10),
-				AMQPAddressString(e.Subscribe.Endpoint)}, "@"),
-			Reduce); err != nil {
-			log.Error("reduce entity subscribe endpoint err")
-			continue
-		} else {
-			if err := updateEntitySubscribeEndpoint(e.EntityID,
-				strings.Join([]string{newTitle, strconv.FormatUint(uint64(e.SubscribeID), 10),
-					AMQPAddressString(e.Subscribe.Endpoint)}, "@"),
-				Add); err != nil {
-				log.Error("add entity subscribe endpoint err")
-			}
-		}
-	}
-	return nil
+	return errors.Wrap(err, "query subscribe")
 }
 
 func (s *Subscribe) BeforeDelete(tx *gorm.DB) error {
@@ -87,6 +84,9 @@ func destroyRelevant(tx *gorm.DB, subscribe *Subscribe) error {
 		log.Error("Find deleted subscription relevants error:", result.Error)
 		return result.Error
 	}
+	if err := archiveRelevant(tx, subscribe, relevants); err != nil {
+		return err
+	}
 	for _, relevant := range relevants {
 		relevant.Subscribe = *subscribe
 		result = tx.Session(&gorm.Session{NewDB: true}).
@@ -103,42 +103,28 @@ func destroyRelevant(tx *gorm.DB, subscribe *Subscribe) error {
 }
 
 type SubscribeEntities struct {
+	TenantID    string `gorm:"index;not null;default:''"`
 	EntityID    string `gorm:"index;not null"`
 	UniqueKey   string `gorm:"index;unique;size:255"`
 	SubscribeID uint   `gorm:"index;not null"`
+	// CreatedAt is when the entity was subscribed.
+	CreatedAt time.Time `gorm:"index"`
 
 	Subscribe Subscribe
 }
 
+// The hooks below never call core directly, they record the core operations
+// in the outbox within the same transaction, see OutboxDispatcher.
+
 func (e *SubscribeEntities) AfterCreate(tx *gorm.DB) error {
-	if e.UniqueKey == "" {
-		return errors.New("UniqueKey is empty")
-	}
-	if e.SubscribeID == 0 {
-		return errors.New("subscribeID id is empty")
-	}
-	if e.EntityID == "" {
-		return errors.New("entityID is empty")
+	if err := e.validate(); err != nil {
+		return err
 	}
-	//tx.Model(&e.Subscribe).Where("id = ?", e.SubscribeID).First(&e.Subscribe)
 	subscribe := Subscribe{}
 	tx.Model(&subscribe).Where("id = ?", e.SubscribeID).First(&subscribe)
 	e.Subscribe = subscribe
 	log.Debug("creation of SubscribeEntities:", *e)
-	if err := createCoreSubscription(e.EntityID, e.Subscribe.Endpoint); err != nil {
-		err = errors.Wrap(err, "create core subscription err")
-		log.Error(err)
-		return err
-	}
-	if err := updateEntitySubscribeEndpoint(e.EntityID,
-		strings.Join([]string{e.Subscribe.Title, strconv.FormatUint(uint64(e.SubscribeID), 10),
-			AMQPAddressString(e.Subscribe.Endpoint)}, "@"),
-		Add); err != nil {
-		err = errors.Wrap(err, "update entity subscribe endpoint err")
-		log.Error(err)
-		return err
-	}
-	return nil
+	return enqueueOutbox(tx, e, OperationSubscribe)
 }
 
 func (e *SubscribeEntities) BeforeUpdate(tx *gorm.DB) error {
@@ -148,48 +134,18 @@ func (e *SubscribeEntities) BeforeUpdate(tx *gorm.DB) error {
 		return nil
 	}
 	log.Debug("deleted of SubscribeEntities:", *e)
-	if err := updateEntitySubscribeEndpoint(e.EntityID,
-		strings.Join([]string{e.Subscribe.Title, strconv.FormatUint(uint64(e.SubscribeID), 10),
-			AMQPAddressString(e.Subscribe.Endpoint)}, "@"),
-		Reduce); err != nil {
-		return err
-	}
-	if err := deleteCoreSubscription(e.EntityID, e.Subscribe.Endpoint); err != nil {
-		log.Error(err)
-		return err
-	}
-	return nil
+	return enqueueOutbox(tx, e, OperationUnsubscribe)
 }
 
 func (e *SubscribeEntities) AfterUpdate(tx *gorm.DB) error {
-	if e.UniqueKey == "" {
-		return errors.New("UniqueKey is empty")
-	}
-	if e.SubscribeID == 0 {
-		return errors.New("subscribeID id is empty")
-	}
-	if e.EntityID == "" {
-		return errors.New("entityID is empty")
+	if err := e.validate(); err != nil {
+		return err
 	}
 	subscribe := Subscribe{}
 	tx.Model(&subscribe).Where("id = ?", e.SubscribeID).First(&subscribe)
 	e.Subscribe = subscribe
-	//	tx.Model(&e.Subscribe).Where("id = ?", e.SubscribeID).First(&e.Subscribe)
 	log.Debug("creation of SubscribeEntities:", *e)
-	if err := createCoreSubscription(e.EntityID, e.Subscribe.Endpoint); err != nil {
-		err = errors.Wrap(err, "create core subscription err")
-		log.Error(err)
-		return err
-	}
-	if err := updateEntitySubscribeEndpoint(e.EntityID,
-		strings.Join([]string{e.Subscribe.Title, strconv.FormatUint(uint64(e.SubscribeID), 10),
-			AMQPAddressString(e.Subscribe.Endpoint)}, "@"),
-		Add); err != nil {
-		err = errors.Wrap(err, "update entity subscribe endpoint err")
-		log.Error(err)
-		return err
-	}
-	return nil
+	return enqueueOutbox(tx, e, OperationSubscribe)
 }
 
 func (e *SubscribeEntities) BeforeDelete(tx *gorm.DB) error {
@@ -199,27 +155,22 @@ func (e *SubscribeEntities) BeforeDelete(tx *gorm.DB) error {
 		return nil
 	}
 	log.Debug("deleted of SubscribeEntities:", *e)
-	if err := updateEntitySubscribeEndpoint(e.EntityID,
-		strings.Join([]string{e.Subscribe.Title, strconv.FormatUint(uint64(e.SubscribeID), 10),
-			AMQPAddressString(e.Subscribe.Endpoint)}, "@"),
-		Reduce); err != nil {
-		return err
+	return enqueueOutbox(tx, e, OperationUnsubscribe)
+}
+
+func (e *SubscribeEntities) validate() error {
+	if e.UniqueKey == "" {
+		return errors.New("UniqueKey is empty")
 	}
-	if err := deleteCoreSubscription(e.EntityID, e.Subscribe.Endpoint); err != nil {
-		log.Error(err)
-		return err
+	if e.SubscribeID == 0 {
+		return errors.New("subscribeID id is empty")
+	}
+	if e.EntityID == "" {
+		return errors.New("entityID is empty")
 	}
 	return nil
 }
 
-func createCoreSubscription(entityID string, topic string) error {
-	return coreClient.Subscribe(subscriptionIDByMD5AndPrefix(entityID, topic), entityID, topic)
-}
-
-func deleteCoreSubscription(entityID string, topic string) error {
-	return coreClient.Unsubscribe(subscriptionIDByMD5AndPrefix(entityID, topic))
-}
-
 type UtilChoice uint8
 
 const (
@@ -227,56 +178,133 @@ const (
 	Reduce
 )
 
-func updateEntitySubscribeEndpoint(entityID, endpoint string, c UtilChoice) error {
-	separator := ","
-	patchData := make([]map[string]interface{}, 0)
+const (
+	maxPatchAttempts   = 5
+	patchRetryInterval = 20 * time.Millisecond
+)
 
-	device, err := coreClient.GetDeviceEntity(entityID)
-	log.Debug("get device entity:", device)
-	if err != nil {
-		log.Error("get entity err:", err)
-		return err
-	}
-	subscribeAddr := endpoint
-	switch c {
-	case Add:
-		if strings.Contains(device.Properties.SysField.SubscribeAddr, endpoint) {
+// updateEntitySubscribeEndpoint adds or removes addr in sysField._subscribeAddr of the entity.
+func updateEntitySubscribeEndpoint(entityID string, addr subscribeuril.Address, c UtilChoice) error {
+	return modifyEntitySubscribeAddr(entityID, func(subscribeAddr string) (string, bool, error) {
+		return mergeSubscribeAddr(subscribeAddr, addr, c)
+	})
+}
+
+// modifyEntitySubscribeAddr patches sysField._subscribeAddr of the entity with the result of modify.
+// Calls on the same entity are serialized across the replicas of the broker by withEntityLock, and
+// because core has no compare-and-swap on properties, every patch is read back and retried when a
+// writer outside the broker replaced the value in between.
+func modifyEntitySubscribeAddr(entityID string, modify func(subscribeAddr string) (string, bool, error)) error {
+	return withEntityLock(entityID, func() error {
+		return patchEntitySubscribeAddr(entityID, modify)
+	})
+}
+
+func patchEntitySubscribeAddr(entityID string, modify func(subscribeAddr string) (string, bool, error)) error {
+	for attempt := 1; attempt <= maxPatchAttempts; attempt++ {
+		device, err := coreClient.GetDeviceEntity(entityID)
+		log.Debug("get device entity:", device)
+		if err != nil {
+			log.Error("get entity err:", err)
+			return err
+		}
+		subscribeAddr, changed, err := modify(device.Properties.SysField.SubscribeAddr)
+		if err != nil {
+			return errors.Wrapf(err, "modify subscribeAddr of entity %s", entityID)
+		}
+		if !changed {
 			return nil
 		}
-		if device.Properties.SysField.SubscribeAddr != "" {
-			subscribeAddr = strings.Join([]string{device.Properties.SysField.SubscribeAddr, endpoint}, separator)
+		log.Debugf("generated subscribeAddr: %s", subscribeAddr)
+
+		patchData := []map[string]interface{}{{
+			"operator": "replace",
+			"path":     "sysField._subscribeAddr",
+			"value":    subscribeAddr,
+		}}
+		log.Debug("patchData:", patchData)
+		if err = coreClient.PatchEntity(entityID, patchData); err != nil {
+			err = errors.Wrap(err, "patch entity err")
+			return err
 		}
-	case Reduce:
-		addrs := strings.Split(device.Properties.SysField.SubscribeAddr, separator)
-		validAddresses := make([]string, 0, len(addrs))
-		for i := range addrs {
-			if addrs[i] != endpoint {
-				log.Debugf("addrs[i]: %v, endpoint: %v", addrs[i], endpoint)
-				validAddresses = append(validAddresses, addrs[i])
-			}
+
+		device, err = coreClient.GetDeviceEntity(entityID)
+		if err != nil {
+			log.Error("get entity err:", err)
+			return err
 		}
-		if len(validAddresses) != 0 {
-			subscribeAddr = strings.Join(validAddresses, separator)
-		} else {
-			subscribeAddr = ""
+		if device.Properties.SysField.SubscribeAddr == subscribeAddr {
+			return nil
 		}
-		log.Debugf("generated subscribeAddr: %s", subscribeAddr)
+		log.Warnf("subscribeAddr of entity %s changed concurrently, retry %d", entityID, attempt)
+		time.Sleep(time.Duration(attempt) * patchRetryInterval)
 	}
 
-	patchData = append(patchData, map[string]interface{}{
-		"operator": "replace",
-		"path":     "sysField._subscribeAddr",
-		"value":    subscribeAddr,
-	})
+	return errors.Wrapf(ErrConcurrentModification, "update subscribeAddr of entity %s", entityID)
+}
 
-	log.Debug("patchData:", patchData)
-	log.Debug("call patch on UtilChoice (Add 1, Reduce 2):", c)
+// mergeSubscribeAddr applies the UtilChoice of addr on subscribeAddr, addresses are keyed by subscribe ID.
+// changed reports whether the result differs from the given subscribeAddr,
+// a legacy subscribeAddr is always rewritten into the structured format.
+func mergeSubscribeAddr(subscribeAddr string, addr subscribeuril.Address, c UtilChoice) (result string, changed bool, err error) {
+	addrs, err := subscribeuril.ParseAddresses(subscribeAddr)
+	if err != nil {
+		return "", false, err
+	}
+	changed = subscribeuril.IsLegacyAddresses(subscribeAddr)
 
-	if err = coreClient.PatchEntity(entityID, patchData); err != nil {
-		err = errors.Wrap(err, "patch entity err")
-		return err
+	validAddresses := make([]subscribeuril.Address, 0, len(addrs)+1)
+	var existing *subscribeuril.Address
+	for i := range addrs {
+		if addrs[i].SubscribeID == addr.SubscribeID {
+			existing = &addrs[i]
+			continue
+		}
+		validAddresses = append(validAddresses, addrs[i])
+	}
+
+	switch c {
+	case Add:
+		if existing == nil || *existing != addr || len(validAddresses) != len(addrs)-1 {
+			changed = true
+		}
+		validAddresses = append(validAddresses, addr)
+	case Reduce:
+		if existing != nil {
+			changed = true
+		}
+	}
+	if !changed {
+		return subscribeAddr, false, nil
 	}
+	return subscribeuril.FormatAddresses(validAddresses), true, nil
+}
 
+// MigrateSubscribeAddr rewrites the legacy "title@subscribeID@endpoint" sysField._subscribeAddr
+// of every subscribed entity into the structured format.
+func MigrateSubscribeAddr() error {
+	entityIDs := make([]string, 0)
+	if err := DB().Model(&SubscribeEntities{}).Distinct("entity_id").Pluck("entity_id", &entityIDs).Error; err != nil {
+		return errors.Wrap(err, "list subscribed entities")
+	}
+
+	failed := 0
+	for _, entityID := range entityIDs {
+		err := modifyEntitySubscribeAddr(entityID, func(subscribeAddr string) (string, bool, error) {
+			if !subscribeuril.IsLegacyAddresses(subscribeAddr) {
+				return subscribeAddr, false, nil
+			}
+			addrs, err := subscribeuril.ParseAddresses(subscribeAddr)
+			return subscribeuril.FormatAddresses(addrs), true, err
+		})
+		if err != nil {
+			log.Errorf("migrate subscribeAddr of entity %s err: %v", entityID, err)
+			failed++
+		}
+	}
+	if failed != 0 {
+		return errors.Errorf("migrate subscribeAddr failed on %d of %d entities", failed, len(entityIDs))
+	}
 	return nil
 }
 
@@ -1,11 +1,46 @@
 package model
 
 import (
-	"github.com/stretchr/testify/assert"
+	"fmt"
 	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/core"
+	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
 )
 
+func TestFindUserSubscribe(t *testing.T) {
+	useTestDB(t)
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+
+	found := Subscribe{}
+	assert.NoError(t, FindUserSubscribe(&found, "tenant", "user", sub.ID))
+	assert.Equal(t, sub.ID, found.ID)
+
+	tests := []struct {
+		name   string
+		tenant string
+		user   string
+		id     uint
+	}{
+		{"another tenant", "other", "user", sub.ID},
+		{"another user", "tenant", "other", sub.ID},
+		{"unknown subscribe", "tenant", "user", sub.ID + 1},
+	}
+	for _, test := range tests {
+		found := Subscribe{}
+		err := FindUserSubscribe(&found, test.tenant, test.user, test.id)
+		assert.True(t, errors.Is(err, ErrSubscribeMismatch), test.name)
+		assert.Contains(t, err.Error(), "mismatch", test.name)
+	}
+}
+
 func TestUpdateEntitySubscribeEndpoint(t *testing.T) {
 	subscribeAddr := ""
 	data := "Default Title@4@amqp://tkeel.io:5672/AAa1yvw7dYJGkuQU,123132@5@amqp://tkeel.io:5672/soV8UVBhdyLakMpR,1@6@amqp://tkeel.io:5672/Zwm1ihXdD7Q7eGcg,1test@7@amqp://tkeel.io:5672/ORc25nkwSMOUHSDe"
@@ -26,3 +61,131 @@ func TestUpdateEntitySubscribeEndpoint(t *testing.T) {
 
 	assert.Equal(t, subscribeAddr, "123132@5@amqp://tkeel.io:5672/soV8UVBhdyLakMpR,1@6@amqp://tkeel.io:5672/Zwm1ihXdD7Q7eGcg,1test@7@amqp://tkeel.io:5672/ORc25nkwSMOUHSDe")
 }
+
+type fakeCore struct {
+	mu    sync.Mutex
+	addrs map[string]string
+	// lose drops the next n patches, like a writer outside the broker overwriting them.
+	lose int
+}
+
+func newFakeCore() *fakeCore {
+	return &fakeCore{addrs: make(map[string]string)}
+}
+
+func (f *fakeCore) Subscribe(subscriptionID, entityID, topic string) error { return nil }
+
+func (f *fakeCore) Unsubscribe(subscriptionID string) error { return nil }
+
+func (f *fakeCore) Publish(topic string, data interface{}) error { return nil }
+
+func (f *fakeCore) GetDeviceEntity(entityID string) (*core.Entity, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	e := &core.Entity{Id: entityID}
+	e.Properties.SysField.SubscribeAddr = f.addrs[entityID]
+	return e, nil
+}
+
+func (f *fakeCore) PatchEntity(entityID string, data []map[string]interface{}) error {
+	// Widen the window between read and write.
+	time.Sleep(time.Millisecond)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.lose > 0 {
+		f.lose--
+		return nil
+	}
+	f.addrs[entityID] = data[0]["value"].(string)
+	return nil
+}
+
+func TestUpdateEntitySubscribeEndpointConcurrently(t *testing.T) {
+	useTestDB(t)
+	fc := newFakeCore()
+	coreClient = fc
+	defer func() { coreClient = nil }()
+
+	const n = 50
+	var wg sync.WaitGroup
+	for i := 1; i <= n; i++ {
+		wg.Add(1)
+		go func(i uint) {
+			defer wg.Done()
+			addr := subscribeuril.NewAddress(i, fmt.Sprintf("amqp://localhost:3172/endpoint-%d", i))
+			assert.NoError(t, updateEntitySubscribeEndpoint("device", addr, Add))
+		}(uint(i))
+	}
+	wg.Wait()
+	addrs, err := subscribeuril.ParseAddresses(fc.addrs["device"])
+	assert.NoError(t, err)
+	assert.Len(t, addrs, n)
+
+	for i := 2; i <= n; i += 2 {
+		wg.Add(1)
+		go func(i uint) {
+			defer wg.Done()
+			assert.NoError(t, updateEntitySubscribeEndpoint("device", subscribeuril.Address{SubscribeID: i}, Reduce))
+		}(uint(i))
+	}
+	wg.Wait()
+	var locks int64
+	assert.NoError(t, DB().Model(&EntityLock{}).Where("entity_id = ?", "device").Count(&locks).Error)
+	assert.Equal(t, int64(1), locks, "the replicas lock the row of the entity")
+	addrs, err = subscribeuril.ParseAddresses(fc.addrs["device"])
+	assert.NoError(t, err)
+	assert.Len(t, addrs, n/2)
+	for _, addr := range addrs {
+		assert.Equal(t, uint(1), addr.SubscribeID%2)
+	}
+}
+
+func TestUpdateEntitySubscribeEndpointRetriesLostPatch(t *testing.T) {
+	useTestDB(t)
+	fc := newFakeCore()
+	fc.lose = 2
+	coreClient = fc
+	defer func() { coreClient = nil }()
+
+	a := subscribeuril.NewAddress(1, "amqp://localhost:3172/a")
+	assert.NoError(t, updateEntitySubscribeEndpoint("device", a, Add))
+	assert.Equal(t, subscribeuril.FormatAddresses([]subscribeuril.Address{a}), fc.addrs["device"])
+
+	fc.lose = maxPatchAttempts
+	b := subscribeuril.NewAddress(2, "amqp://localhost:3172/b")
+	assert.ErrorIs(t, updateEntitySubscribeEndpoint("device", b, Add), ErrConcurrentModification)
+}
+
+func TestMergeSubscribeAddr(t *testing.T) {
+	a := subscribeuril.NewAddress(1, "amqp://localhost:3172/a")
+	b := subscribeuril.NewAddress(2, "amqp://localhost:3172/b")
+	c := subscribeuril.NewAddress(3, "amqp://localhost:3172/c")
+	format := subscribeuril.FormatAddresses
+
+	tests := []struct {
+		name     string
+		addr     string
+		address  subscribeuril.Address
+		choice   UtilChoice
+		excepted string
+		changed  bool
+	}{
+		{"add to empty", "", a, Add, format([]subscribeuril.Address{a}), true},
+		{"add new", format([]subscribeuril.Address{a}), b, Add, format([]subscribeuril.Address{a, b}), true},
+		{"add existing", format([]subscribeuril.Address{a, b}), a, Add, format([]subscribeuril.Address{a, b}), false},
+		{"add moved endpoint", format([]subscribeuril.Address{a}), subscribeuril.NewAddress(1, "amqp://localhost:3172/x"),
+			Add, format([]subscribeuril.Address{subscribeuril.NewAddress(1, "amqp://localhost:3172/x")}), true},
+		{"reduce existing", format([]subscribeuril.Address{a, b, c}), b, Reduce, format([]subscribeuril.Address{a, c}), true},
+		{"reduce last", format([]subscribeuril.Address{a}), a, Reduce, "", true},
+		{"reduce missing", format([]subscribeuril.Address{a, b}), c, Reduce, format([]subscribeuril.Address{a, b}), false},
+		{"legacy existing", "title@1@amqp://localhost:3172/a", a, Add, format([]subscribeuril.Address{a}), true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, changed, err := mergeSubscribeAddr(test.addr, test.address, test.choice)
+			assert.NoError(t, err)
+			assert.Equal(t, test.excepted, result)
+			assert.Equal(t, test.changed, changed)
+		})
+	}
+}
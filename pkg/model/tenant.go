@@ -0,0 +1,92 @@
+package model
+
+import (
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+)
+
+// TenantSummary reports what disabling a tenant has done.
+type TenantSummary struct {
+	Subscribes int
+	Entities   int
+}
+
+func defaultOwner(tenantID, userID string) *string {
+	owner := tenantID + "/" + userID
+	return &owner
+}
+
+// EnsureDefaultSubscribe returns the default subscription of the user, it is created with title
+// and description when the user has none. Concurrent calls create one default subscription only.
+func EnsureDefaultSubscribe(tenantID, userID, title, description string) (*Subscribe, bool, error) {
+	sub := &Subscribe{}
+	result := DB().Where("default_owner = ?", *defaultOwner(tenantID, userID)).Limit(1).Find(sub)
+	if result.Error != nil {
+		return nil, false, errors.Wrap(result.Error, "query default subscribe")
+	}
+	if result.RowsAffected > 0 {
+		return sub, false, nil
+	}
+
+	sub = &Subscribe{
+		TenantID:    tenantID,
+		UserID:      userID,
+		Title:       title,
+		Description: description,
+		IsDefault:   true,
+	}
+	err := DB().Create(sub).Error
+	if errors.Is(err, ErrDuplicateKey) {
+		// created by a concurrent call
+		sub = &Subscribe{}
+		err = DB().Where("default_owner = ?", *defaultOwner(tenantID, userID)).First(sub).Error
+		return sub, false, errors.Wrap(err, "query default subscribe")
+	}
+	if err != nil {
+		return nil, false, errors.Wrap(err, "create default subscribe")
+	}
+	return sub, true, nil
+}
+
+// DisableTenant detaches the entities of every subscription of the tenant and archives the subscriptions,
+// the default ones included. Core subscriptions and sysField._subscribeAddr are cleaned up by the OutboxDispatcher.
+func DisableTenant(tenantID string) (TenantSummary, error) {
+	summary := TenantSummary{}
+	err := DB().Transaction(func(tx *gorm.DB) error {
+		subscribes := make([]Subscribe, 0)
+		if err := tx.Where("tenant_id = ?", tenantID).Find(&subscribes).Error; err != nil {
+			return errors.Wrap(err, "list subscribes of tenant")
+		}
+		for i := range subscribes {
+			var count int64
+			if err := tx.Model(&SubscribeEntities{}).Where("subscribe_id = ?", subscribes[i].ID).Count(&count).Error; err != nil {
+				return errors.Wrap(err, "count subscribe entities")
+			}
+			if err := destroyRelevant(tx, &subscribes[i]); err != nil {
+				return err
+			}
+			summary.Entities += int(count)
+		}
+		if len(subscribes) == 0 {
+			return nil
+		}
+
+		// the hooks refuse to delete default subscriptions, which is what disabling does
+		if err := tx.Session(&gorm.Session{SkipHooks: true}).Model(&Subscribe{}).
+			Where("tenant_id = ?", tenantID).UpdateColumn("default_owner", nil).Error; err != nil {
+			return errors.Wrap(err, "release default subscribes")
+		}
+		if err := tx.Session(&gorm.Session{SkipHooks: true}).
+			Where("tenant_id = ?", tenantID).Delete(&Subscribe{}).Error; err != nil {
+			return errors.Wrap(err, "archive subscribes")
+		}
+		summary.Subscribes = len(subscribes)
+		return nil
+	})
+	if err != nil {
+		return TenantSummary{}, err
+	}
+	log.Infof("disabled tenant %s: archived %d subscribes, unsubscribed %d entities", tenantID, summary.Subscribes, summary.Entities)
+	return summary, nil
+}
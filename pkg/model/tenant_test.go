@@ -0,0 +1,73 @@
+package model
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnsureDefaultSubscribe(t *testing.T) {
+	useTestDB(t)
+
+	const n = 10
+	ids := make(chan uint, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sub, _, err := EnsureDefaultSubscribe("tenant", "user", "default", "")
+			assert.NoError(t, err)
+			ids <- sub.ID
+		}()
+	}
+	wg.Wait()
+	close(ids)
+
+	var count int64
+	DB().Model(&Subscribe{}).Where("tenant_id = ? AND user_id = ? AND is_default = ?", "tenant", "user", true).Count(&count)
+	assert.Equal(t, int64(1), count)
+	first := <-ids
+	for id := range ids {
+		assert.Equal(t, first, id)
+	}
+
+	other, created, err := EnsureDefaultSubscribe("other", "user", "default", "")
+	assert.NoError(t, err)
+	assert.True(t, created, "the same user of another tenant has its own default subscribe")
+	assert.NotEqual(t, first, other.ID)
+}
+
+func TestDisableTenant(t *testing.T) {
+	useTestDB(t)
+
+	def, _, err := EnsureDefaultSubscribe("tenant", "user", "default", "")
+	assert.NoError(t, err)
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	kept := Subscribe{TenantID: "kept", UserID: "user", Title: "kept"}
+	assert.NoError(t, DB().Create(&kept).Error)
+	for _, s := range []Subscribe{*def, sub, kept} {
+		_, _, err = SubscribeEntitiesInBatches(&s, []string{"d1", "d2"}, 0, nil)
+		assert.NoError(t, err)
+	}
+
+	summary, err := DisableTenant("tenant")
+	assert.NoError(t, err)
+	assert.Equal(t, TenantSummary{Subscribes: 2, Entities: 4}, summary)
+
+	var count int64
+	DB().Model(&Subscribe{}).Where("tenant_id = ?", "tenant").Count(&count)
+	assert.Equal(t, int64(0), count)
+	DB().Model(&Subscribe{}).Unscoped().Where("tenant_id = ?", "tenant").Count(&count)
+	assert.Equal(t, int64(2), count, "subscribes are archived")
+	DB().Model(&SubscribeEntities{}).Count(&count)
+	assert.Equal(t, int64(2), count, "only the entities of the other tenant are left")
+	DB().Model(&Outbox{}).Where("operation = ?", OperationUnsubscribe).Count(&count)
+	assert.Equal(t, int64(4), count)
+
+	_, created, err := EnsureDefaultSubscribe("tenant", "user", "default", "")
+	assert.NoError(t, err)
+	assert.True(t, created, "enabling the tenant again provisions a new default subscribe")
+}
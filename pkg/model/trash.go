@@ -0,0 +1,255 @@
+package model
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core-broker/pkg/pagination"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+)
+
+const (
+	// subscribeRetentionEnvKey is how long deleted subscriptions are kept, like "720h", "0" keeps them forever.
+	subscribeRetentionEnvKey = "DELETED_SUBSCRIBE_RETENTION"
+
+	DefaultSubscribeRetention = 30 * 24 * time.Hour
+	defaultPurgeInterval      = time.Hour
+)
+
+// ArchivedSubscribeEntity is an entity of a deleted subscription, kept so RestoreSubscribe brings it back.
+type ArchivedSubscribeEntity struct {
+	ID          uint   `gorm:"primarykey"`
+	TenantID    string `gorm:"index;not null;default:''"`
+	SubscribeID uint   `gorm:"index;not null"`
+	EntityID    string `gorm:"size:255;not null"`
+	ArchivedAt  time.Time
+}
+
+// DeletedSubscribe is a deleted subscription and the number of its archived entities.
+type DeletedSubscribe struct {
+	Subscribe
+	Entities int64
+}
+
+// archiveRelevant keeps the entities of the subscription being deleted in the transaction tx.
+func archiveRelevant(tx *gorm.DB, subscribe *Subscribe, relevants []SubscribeEntities) error {
+	if len(relevants) == 0 {
+		return nil
+	}
+	now := time.Now()
+	archives := make([]ArchivedSubscribeEntity, 0, len(relevants))
+	for _, relevant := range relevants {
+		archives = append(archives, ArchivedSubscribeEntity{
+			TenantID:    subscribe.TenantID,
+			SubscribeID: subscribe.ID,
+			EntityID:    relevant.EntityID,
+			ArchivedAt:  now,
+		})
+	}
+	err := tx.Session(&gorm.Session{NewDB: true}).CreateInBatches(&archives, DefaultBulkBatchSize).Error
+	return errors.Wrap(err, "archive subscribe entities")
+}
+
+// ListDeletedSubscribes returns a page of the deleted subscriptions of the user, the latest deleted first,
+// and how many there are.
+func ListDeletedSubscribes(tenantID, userID string, page pagination.Page) ([]DeletedSubscribe, int64, error) {
+	query := DB().Unscoped().Model(&Subscribe{}).
+		Where("tenant_id = ? AND user_id = ? AND deleted_at IS NOT NULL", tenantID, userID)
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "count deleted subscribes")
+	}
+	subscribes := make([]Subscribe, 0)
+	query = query.Order("deleted_at DESC, id DESC")
+	if page.Cursor != nil {
+		query = afterCursor(query, "deleted_at", "id", page.Cursor, page.Cursor.ID, true).Limit(int(page.Limit()))
+	} else if page.Required() {
+		query = query.Limit(int(page.Limit())).Offset(int(page.Offset()))
+	}
+	if err := query.Find(&subscribes).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "list deleted subscribes")
+	}
+	if len(subscribes) == 0 {
+		return []DeletedSubscribe{}, total, nil
+	}
+
+	ids := make([]uint, 0, len(subscribes))
+	for i := range subscribes {
+		ids = append(ids, subscribes[i].ID)
+	}
+	rows := make([]struct {
+		SubscribeID uint
+		Count       int64
+	}, 0)
+	if err := DB().Model(&ArchivedSubscribeEntity{}).Select("subscribe_id, count(*) AS count").
+		Where("subscribe_id IN ?", ids).Group("subscribe_id").Scan(&rows).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "count archived entities")
+	}
+	counts := make(map[uint]int64, len(rows))
+	for _, row := range rows {
+		counts[row.SubscribeID] = row.Count
+	}
+	deleted := make([]DeletedSubscribe, 0, len(subscribes))
+	for i := range subscribes {
+		deleted = append(deleted, DeletedSubscribe{Subscribe: subscribes[i], Entities: counts[subscribes[i].ID]})
+	}
+	return deleted, total, nil
+}
+
+// FindDeletedSubscribe loads the deleted subscription id of the user of the tenant into subscribe. It returns
+// ErrRecordNotFound when the user has no such deleted subscription.
+func FindDeletedSubscribe(subscribe *Subscribe, tenantID, userID string, id uint) error {
+	return errors.Wrap(deletedSubscribe(DB(), tenantID, userID, id).First(subscribe).Error, "query deleted subscribe")
+}
+
+func deletedSubscribe(db *gorm.DB, tenantID, userID string, id uint) *gorm.DB {
+	return db.Unscoped().Where("id = ? AND tenant_id = ? AND user_id = ? AND deleted_at IS NOT NULL", id, tenantID, userID)
+}
+
+// ArchivedEntityIDs returns the archived entities of the deleted subscription.
+func ArchivedEntityIDs(subscribeID uint) ([]string, error) {
+	ids := make([]string, 0)
+	err := DB().Model(&ArchivedSubscribeEntity{}).Where("subscribe_id = ?", subscribeID).Pluck("entity_id", &ids).Error
+	return ids, errors.Wrap(err, "list archived entities")
+}
+
+// RestoreSubscribe brings back the deleted subscription of the user and subscribes its archived entities again,
+// their core subscriptions are restored by the OutboxDispatcher. A restored default subscription stays the default
+// one unless the user has got another default subscription meanwhile. It returns ErrRecordNotFound when the user
+// has no such deleted subscription.
+func RestoreSubscribe(tenantID, userID string, id uint) (*Subscribe, int, error) {
+	subscribe := &Subscribe{}
+	restored := 0
+	err := DB().Transaction(func(tx *gorm.DB) error {
+		if err := deletedSubscribe(tx, tenantID, userID, id).First(subscribe).Error; err != nil {
+			return errors.Wrap(err, "query deleted subscribe")
+		}
+
+		updates := map[string]interface{}{"deleted_at": nil}
+		if subscribe.IsDefault && subscribe.DefaultOwner == nil {
+			var count int64
+			owner := defaultOwner(tenantID, userID)
+			if err := tx.Model(&Subscribe{}).Where("default_owner = ?", *owner).Count(&count).Error; err != nil {
+				return errors.Wrap(err, "query default subscribe")
+			}
+			if count == 0 {
+				updates["default_owner"] = *owner
+				subscribe.DefaultOwner = owner
+			} else {
+				updates["is_default"] = false
+				subscribe.IsDefault = false
+			}
+		}
+		if err := tx.Session(&gorm.Session{SkipHooks: true}).Unscoped().Model(&Subscribe{}).
+			Where("id = ?", subscribe.ID).UpdateColumns(updates).Error; err != nil {
+			return errors.Wrap(err, "restore subscribe")
+		}
+		subscribe.DeletedAt = gorm.DeletedAt{}
+
+		entityIDs := make([]string, 0)
+		if err := tx.Model(&ArchivedSubscribeEntity{}).Where("subscribe_id = ?", subscribe.ID).
+			Pluck("entity_id", &entityIDs).Error; err != nil {
+			return errors.Wrap(err, "list archived entities")
+		}
+		entityIDs = uniqueStrings(entityIDs)
+		for start := 0; start < len(entityIDs); start += DefaultBulkBatchSize {
+			end := start + DefaultBulkBatchSize
+			if end > len(entityIDs) {
+				end = len(entityIDs)
+			}
+			created, err := subscribeBatch(tx, subscribe, entityIDs[start:end])
+			if err != nil {
+				return err
+			}
+			restored += len(created)
+		}
+		return errors.Wrap(tx.Where("subscribe_id = ?", subscribe.ID).Delete(&ArchivedSubscribeEntity{}).Error,
+			"delete archived entities")
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	log.Infof("restored subscribe %d with %d entities", subscribe.ID, restored)
+	return subscribe, restored, nil
+}
+
+// PurgeDeletedSubscribes removes for good the subscriptions deleted before deadline and their archived entities,
+// and returns how many subscriptions were removed.
+func PurgeDeletedSubscribes(deadline time.Time) (int64, error) {
+	var purged int64
+	err := DB().Transaction(func(tx *gorm.DB) error {
+		ids := make([]uint, 0)
+		if err := tx.Unscoped().Model(&Subscribe{}).
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", deadline).Pluck("id", &ids).Error; err != nil {
+			return errors.Wrap(err, "list expired subscribes")
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := tx.Where("subscribe_id IN ?", ids).Delete(&ArchivedSubscribeEntity{}).Error; err != nil {
+			return errors.Wrap(err, "purge archived entities")
+		}
+		if err := tx.Where("subscribe_id IN ?", ids).Delete(&SelectorEntity{}).Error; err != nil {
+			return errors.Wrap(err, "purge selector entities")
+		}
+		if err := tx.Where("subscribe_id IN ?", ids).Delete(&Selector{}).Error; err != nil {
+			return errors.Wrap(err, "purge selectors")
+		}
+		result := tx.Session(&gorm.Session{SkipHooks: true}).Unscoped().Where("id IN ?", ids).Delete(&Subscribe{})
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "purge subscribes")
+		}
+		purged = result.RowsAffected
+		return nil
+	})
+	return purged, err
+}
+
+// SubscribeRetention returns how long deleted subscriptions are kept, zero keeps them forever.
+func SubscribeRetention() (time.Duration, error) {
+	env := os.Getenv(subscribeRetentionEnvKey)
+	if env == "" {
+		return DefaultSubscribeRetention, nil
+	}
+	retention, err := time.ParseDuration(env)
+	if err != nil || retention < 0 {
+		return 0, errors.Errorf("invalid %s %q, want a non-negative duration like \"720h\"", subscribeRetentionEnvKey, env)
+	}
+	return retention, nil
+}
+
+// RetentionPurger removes the deleted subscriptions older than Retention every Interval.
+type RetentionPurger struct {
+	Retention time.Duration
+	Interval  time.Duration
+}
+
+func NewRetentionPurger(retention time.Duration) *RetentionPurger {
+	return &RetentionPurger{Retention: retention, Interval: defaultPurgeInterval}
+}
+
+// Run purges until ctx is done, it returns at once when deleted subscriptions are kept forever.
+func (p *RetentionPurger) Run(ctx context.Context) {
+	if p.Retention == 0 {
+		log.Info("deleted subscribes are kept forever")
+		return
+	}
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+	for {
+		purged, err := PurgeDeletedSubscribes(time.Now().Add(-p.Retention))
+		if err != nil {
+			log.Error("purge deleted subscribes err:", err)
+		} else if purged > 0 {
+			log.Infof("purged %d subscribes deleted more than %s ago", purged, p.Retention)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
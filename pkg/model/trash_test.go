@@ -0,0 +1,120 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core-broker/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+func TestRestoreSubscribe(t *testing.T) {
+	useTestDB(t)
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	_, _, err := SubscribeEntitiesInBatches(&sub, []string{"d1", "d2"}, 0, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, DB().Delete(&sub).Error)
+
+	deleted, total, err := ListDeletedSubscribes("tenant", "user", pagination.Page{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, sub.ID, deleted[0].ID)
+	assert.Equal(t, int64(2), deleted[0].Entities)
+	_, total, err = ListDeletedSubscribes("tenant", "other", pagination.Page{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+
+	found := Subscribe{}
+	assert.NoError(t, FindDeletedSubscribe(&found, "tenant", "user", sub.ID))
+	assert.Equal(t, sub.ID, found.ID)
+	assert.ErrorIs(t, FindDeletedSubscribe(&found, "another", "user", sub.ID), gorm.ErrRecordNotFound, "another tenant")
+	assert.ErrorIs(t, FindDeletedSubscribe(&found, "tenant", "other", sub.ID), gorm.ErrRecordNotFound, "another user")
+
+	_, _, err = RestoreSubscribe("tenant", "other", sub.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	restored, count, err := RestoreSubscribe("tenant", "user", sub.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, sub.Endpoint, restored.Endpoint)
+	_, _, err = RestoreSubscribe("tenant", "user", sub.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound, "a subscribe is restored once")
+	assert.ErrorIs(t, FindDeletedSubscribe(&found, "tenant", "user", sub.ID), gorm.ErrRecordNotFound, "a restored subscribe is not deleted")
+
+	var n int64
+	DB().Model(&SubscribeEntities{}).Where("subscribe_id = ?", sub.ID).Count(&n)
+	assert.Equal(t, int64(2), n)
+	DB().Model(&ArchivedSubscribeEntity{}).Count(&n)
+	assert.Equal(t, int64(0), n)
+	DB().Model(&Outbox{}).Where("operation = ?", OperationSubscribe).Count(&n)
+	assert.Equal(t, int64(4), n, "the core subscriptions are restored by the outbox")
+}
+
+func TestRestoreDefaultSubscribe(t *testing.T) {
+	useTestDB(t)
+
+	def, _, err := EnsureDefaultSubscribe("tenant", "user", "default", "")
+	assert.NoError(t, err)
+	_, err = DisableTenant("tenant")
+	assert.NoError(t, err)
+
+	restored, _, err := RestoreSubscribe("tenant", "user", def.ID)
+	assert.NoError(t, err)
+	assert.True(t, restored.IsDefault, "the user has no other default subscribe")
+	_, err = DisableTenant("tenant")
+	assert.NoError(t, err)
+
+	_, created, err := EnsureDefaultSubscribe("tenant", "user", "default", "")
+	assert.NoError(t, err)
+	assert.True(t, created)
+	restored, _, err = RestoreSubscribe("tenant", "user", def.ID)
+	assert.NoError(t, err)
+	assert.False(t, restored.IsDefault, "the user has got another default subscribe")
+}
+
+func TestPurgeDeletedSubscribes(t *testing.T) {
+	useTestDB(t)
+
+	sub := Subscribe{TenantID: "tenant", UserID: "user", Title: "sub"}
+	assert.NoError(t, DB().Create(&sub).Error)
+	_, _, err := SubscribeEntitiesInBatches(&sub, []string{"d1"}, 0, nil)
+	assert.NoError(t, err)
+	kept := Subscribe{TenantID: "tenant", UserID: "user", Title: "kept"}
+	assert.NoError(t, DB().Create(&kept).Error)
+	assert.NoError(t, DB().Delete(&sub).Error)
+
+	purged, err := PurgeDeletedSubscribes(time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), purged, "deleted within the retention")
+	purged, err = PurgeDeletedSubscribes(time.Now().Add(time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	var n int64
+	DB().Model(&Subscribe{}).Unscoped().Count(&n)
+	assert.Equal(t, int64(1), n)
+	DB().Model(&ArchivedSubscribeEntity{}).Count(&n)
+	assert.Equal(t, int64(0), n)
+}
+
+func TestSubscribeRetention(t *testing.T) {
+	tests := []struct {
+		env      string
+		excepted time.Duration
+		err      bool
+	}{
+		{"", DefaultSubscribeRetention, false},
+		{"720h", 720 * time.Hour, false},
+		{"0", 0, false},
+		{"-1h", 0, true},
+		{"month", 0, true},
+	}
+	for _, test := range tests {
+		t.Setenv(subscribeRetentionEnvKey, test.env)
+		retention, err := SubscribeRetention()
+		assert.Equal(t, test.err, err != nil, test.env)
+		assert.Equal(t, test.excepted, retention, test.env)
+	}
+}
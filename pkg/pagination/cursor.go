@@ -0,0 +1,53 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Cursor is the position of the last item of a page, the next page starts after it.
+// Lists are ordered by ID, or by Time then Key when the ID does not follow their order.
+type Cursor struct {
+	ID   uint64     `json:"i,omitempty"`
+	Time *time.Time `json:"t,omitempty"`
+	Key  string     `json:"k,omitempty"`
+}
+
+// Token returns the opaque page token of the cursor.
+func (c Cursor) Token() string {
+	// a Cursor is always encoded
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// ParseToken returns the cursor of a page token.
+func ParseToken(token string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidPageToken, err.Error())
+	}
+	c := &Cursor{}
+	if err = json.Unmarshal(b, c); err != nil {
+		return nil, errors.Wrap(ErrInvalidPageToken, err.Error())
+	}
+	return c, nil
+}
+
+// TimeCursor returns the cursor of an item ordered by time then key.
+func TimeCursor(t time.Time, key string) Cursor {
+	return Cursor{Time: &t, Key: key}
+}
+
+// SetNext sets the token of the next page to c when the page is full, count is the number of items of the page.
+// A page which is not limited has no next page.
+func (p *Page) SetNext(c Cursor, count int) {
+	if limit := p.Limit(); limit == 0 || count < int(limit) || !(p.Required() || p.Cursor != nil) {
+		return
+	}
+	p.NextToken = c.Token()
+}
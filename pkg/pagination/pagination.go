@@ -28,10 +28,15 @@ type Page struct {
 	Total            uint
 	defaultSize      uint
 	defaultSeparator string
+
+	// Cursor is parsed from the page token, the page starts after it instead of at Num.
+	Cursor *Cursor
+	// NextToken is the page token of the next page, see SetNext.
+	NextToken string
 }
 
 func (p Page) Offset() uint32 {
-	if p.Num <= 0 {
+	if p.Num <= 0 || p.Cursor != nil {
 		return 0
 	}
 	return uint32((p.Num - 1) * p.Size)
@@ -42,7 +47,7 @@ func (p Page) Limit() uint32 {
 		return uint32(p.Size)
 	}
 
-	if p.Num != 0 {
+	if p.Num != 0 || p.Cursor != nil {
 		return uint32(p.defaultSize)
 	}
 
@@ -123,6 +128,8 @@ func (p Page) FillResponse(resp interface{}) error {
 				}
 				v.Field(i).SetUint(uint64(lastPage + 1))
 
+			case "NextPageToken":
+				v.Field(i).SetString(p.NextToken)
 			case "PageSize":
 				if p.Size == 0 {
 					v.Field(i).SetUint(uint64(p.Total))
@@ -216,6 +223,19 @@ func Parse(req interface{}, options ...Option) (Page, error) {
 				} else {
 					return q, ErrInvalidSearchKey
 				}
+			case "PageToken":
+				val, ok := v.Field(i).Interface().(string)
+				if !ok {
+					return q, ErrInvalidPageToken
+				}
+				if val == "" {
+					continue
+				}
+				cursor, err := ParseToken(val)
+				if err != nil {
+					return q, err
+				}
+				q.Cursor = cursor
 			}
 		}
 	}
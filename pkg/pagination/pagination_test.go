@@ -3,7 +3,9 @@ package pagination
 import (
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -330,3 +332,41 @@ func TestPage_FillResponse(t *testing.T) {
 		assert.True(t, reflect.DeepEqual(test.excepted, *resp))
 	}
 }
+
+func TestPageToken(t *testing.T) {
+	type tokenRequest struct {
+		PageSize  uint
+		PageToken string
+	}
+	type tokenResponse struct {
+		PageSize      uint
+		NextPageToken string
+	}
+	at := time.Date(2022, 3, 1, 8, 0, 0, 123456789, time.UTC)
+	cursor := TimeCursor(at, "device")
+
+	page, err := Parse(&tokenRequest{PageSize: 2, PageToken: cursor.Token()})
+	assert.NoError(t, err)
+	assert.Equal(t, &cursor, page.Cursor)
+	assert.True(t, at.Equal(*page.Cursor.Time))
+	assert.Equal(t, uint32(2), page.Limit())
+	assert.Equal(t, uint32(0), page.Offset())
+
+	page.SetNext(Cursor{ID: 7}, 1)
+	assert.Empty(t, page.NextToken, "the last page is not full")
+	page.SetNext(Cursor{ID: 7}, 2)
+	resp := &tokenResponse{}
+	assert.NoError(t, page.FillResponse(resp))
+	next, err := ParseToken(resp.NextPageToken)
+	assert.NoError(t, err)
+	assert.Equal(t, &Cursor{ID: 7}, next)
+
+	page, err = Parse(&tokenRequest{})
+	assert.NoError(t, err)
+	assert.Nil(t, page.Cursor)
+	page.SetNext(Cursor{ID: 7}, 0)
+	assert.Empty(t, page.NextToken, "the page is not limited")
+
+	_, err = Parse(&tokenRequest{PageToken: "not a token"})
+	assert.True(t, errors.Is(err, ErrInvalidPageToken))
+}
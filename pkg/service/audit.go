@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core-broker/api/subscribe/v1"
+	"github.com/tkeel-io/core-broker/pkg/auth"
+	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/core-broker/pkg/pagination"
+	"github.com/tkeel-io/kit/log"
+)
+
+// auditRecord collects the audit event of a mutating RPC while it runs, the RPC records it when it returns.
+type auditRecord struct {
+	user        auth.User
+	action      string
+	subscribeID uint
+	entityIDs   []string
+	before      interface{}
+	after       interface{}
+	// err fails the outcome of an RPC returning no error, like a partial failure.
+	err error
+}
+
+func newAuditRecord(user auth.User, action string, subscribeID uint) *auditRecord {
+	return &auditRecord{user: user, action: action, subscribeID: subscribeID}
+}
+
+// record saves the event with the outcome of err, a failure to save it is logged only and never fails the RPC.
+func (r *auditRecord) record(err error) {
+	if err == nil {
+		err = r.err
+	}
+	e, recordErr := model.NewAuditEvent(r.user.Tenant, r.user.ID, r.action, r.subscribeID, r.before, r.after, err)
+	if recordErr == nil {
+		recordErr = model.RecordAuditEvent(e, r.entityIDs)
+	}
+	if recordErr != nil {
+		log.Errorf("record audit event %s of subscribe %d err: %v", r.action, r.subscribeID, recordErr)
+	}
+}
+
+// subscribeSnapshot is the audited state of a subscription.
+type subscribeSnapshot struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Endpoint    string `json:"endpoint"`
+	IsDefault   bool   `json:"is_default"`
+	Status      string `json:"status"`
+}
+
+func snapshotSubscribe(sub *model.Subscribe) *subscribeSnapshot {
+	return &subscribeSnapshot{
+		Title:       sub.Title,
+		Description: sub.Description,
+		Endpoint:    sub.Endpoint,
+		IsDefault:   sub.IsDefault,
+		Status:      sub.Status,
+	}
+}
+
+func (s *SubscribeService) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	page, err := pagination.Parse(req)
+	if err != nil {
+		log.Error("parse request page info error:", err)
+		return nil, pb.ErrInvalidArgument()
+	}
+	if req.StartTime < 0 || req.EndTime < 0 || (req.EndTime != 0 && req.EndTime < req.StartTime) {
+		log.Error("err:", errors.Errorf("invalid time range %d-%d", req.StartTime, req.EndTime))
+		return nil, pb.ErrInvalidArgument()
+	}
+
+	filter := model.AuditFilter{
+		TenantID:    authUser.Tenant,
+		SubscribeID: uint(req.SubscribeId),
+		EntityID:    req.EntityId,
+		Actor:       req.Actor,
+	}
+	// the administrators of the tenant see the events of all its users
+	if !authUser.TenantAdmin() {
+		filter.UserID = authUser.ID
+	}
+	if req.StartTime != 0 {
+		filter.Start = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime != 0 {
+		filter.End = time.Unix(req.EndTime, 0)
+	}
+	events, total, err := model.ListAuditEvents(filter, page)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	if n := len(events); n != 0 {
+		page.SetNext(pagination.Cursor{ID: uint64(events[n-1].ID)}, n)
+	}
+
+	page.SetTotal(uint(total))
+	resp := &pb.ListAuditEventsResponse{}
+	if err = page.FillResponse(resp); err != nil {
+		log.Error("err:", err)
+		return nil, err
+	}
+	resp.Data = make([]*pb.AuditEvent, 0, len(events))
+	for i := range events {
+		entities := make([]string, 0, len(events[i].Entities))
+		for _, e := range events[i].Entities {
+			entities = append(entities, e.EntityID)
+		}
+		resp.Data = append(resp.Data, &pb.AuditEvent{
+			Id:          uint64(events[i].ID),
+			Actor:       events[i].Actor,
+			Action:      events[i].Action,
+			SubscribeId: uint64(events[i].SubscribeID),
+			Entities:    entities,
+			Before:      events[i].Before,
+			After:       events[i].After,
+			Outcome:     events[i].Outcome,
+			Error:       events[i].Error,
+			CreatedAt:   events[i].CreatedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
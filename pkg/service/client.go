@@ -300,6 +300,15 @@ func AddDefaultAuthHeader(req *http.Request) {
 	req.Header.Add(tkeelAuthHeader, base64.StdEncoding.EncodeToString([]byte(authString)))
 }
 
+// userAuth returns the x-tKeel-auth header of the user, for the requests the broker makes on its behalf
+// without a request of the user, like the background sync of the selectors. The header is built by the
+// broker and carries no token, so these requests bypass the auth of a user request; they stay scoped to the
+// tenant and the user owning the subscription.
+func userAuth(tenant, user string) string {
+	authString := fmt.Sprintf("tenant=%s&user=%s", tenant, user)
+	return base64.StdEncoding.EncodeToString([]byte(authString))
+}
+
 // generate uuid
 func GetUUID() string {
 	id := uuid.New()
@@ -24,6 +24,12 @@ func (s *DaprSubscribeService) GetSubscribe(ctx context.Context, req *emptypb.Em
 		Metadata:   map[string]string{},
 		Route:      "/v1/topic",
 	})
+	resp.Subscriptions = append(resp.Subscriptions, &pb.TopicSubscription{
+		Pubsubname: types.PubsubName,
+		Topic:      types.FanoutTopic,
+		Metadata:   map[string]string{},
+		Route:      "/v1/topic",
+	})
 
 	return resp, nil
 }
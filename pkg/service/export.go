@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core-broker/api/subscribe/v1"
+	"github.com/tkeel-io/core-broker/pkg/auth"
+	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+)
+
+func (s *SubscribeService) ExportSubscribes(ctx context.Context, req *pb.ExportSubscribesRequest) (*pb.ExportSubscribesResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	format := req.Format
+	if format == "" {
+		format = model.DocumentJSON
+	}
+	if format != model.DocumentJSON && format != model.DocumentYAML {
+		log.Error("err:", errors.Errorf("unknown document format %q", format))
+		return nil, pb.ErrInvalidArgument()
+	}
+
+	ids := make([]uint, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		ids = append(ids, uint(id))
+	}
+	doc, err := model.ExportSubscribes(authUser.Tenant, authUser.ID, ids)
+	if err != nil {
+		log.Error("err:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pb.ErrNotFound()
+		}
+		return nil, pb.ErrInternalQuery()
+	}
+	encoded, err := model.EncodeDocument(doc, format)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	return &pb.ExportSubscribesResponse{
+		Format:   format,
+		Document: string(encoded),
+		Count:    uint64(len(doc.Subscribes)),
+	}, nil
+}
+
+// ImportSubscribes recreates the subscriptions of a document exported by ExportSubscribes, one by one, and reports
+// the result of each. The entities the user does not own in core are not subscribed, the imported selectors
+// are synced right away.
+func (s *SubscribeService) ImportSubscribes(ctx context.Context, req *pb.ImportSubscribesRequest) (*pb.ImportSubscribesResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	conflict := req.Conflict
+	if conflict == "" {
+		conflict = model.ImportSkip
+	}
+	if conflict != model.ImportSkip && conflict != model.ImportOverwrite && conflict != model.ImportRename {
+		log.Error("err:", errors.Errorf("unknown conflict mode %q", conflict))
+		return nil, pb.ErrInvalidArgument()
+	}
+	doc, err := model.DecodeDocument([]byte(req.Document))
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInvalidArgument()
+	}
+
+	resp := &pb.ImportSubscribesResponse{Results: make([]*pb.ImportSubscribeResult, 0, len(doc.Subscribes))}
+	failed, incomplete := 0, 0
+	for i := range doc.Subscribes {
+		result := s.importSubscribe(authUser, &doc.Subscribes[i], conflict)
+		switch {
+		case result.Result == model.ImportFailed:
+			failed++
+		case len(result.SkippedEntities) != 0:
+			incomplete++
+		default:
+			for _, synced := range result.Selectors {
+				if synced.Error != "" {
+					incomplete++
+					break
+				}
+			}
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	switch {
+	case failed != 0 && failed == len(doc.Subscribes):
+		resp.Status = ErrFailure
+	case failed != 0 || incomplete != 0:
+		resp.Status = ErrPartialFailure
+	default:
+		resp.Status = SuccessStatus
+	}
+	return resp, nil
+}
+
+// importSubscribe imports a subscription of a document, the import is audited unless it is skipped.
+func (s *SubscribeService) importSubscribe(authUser auth.User, item *model.PortableSubscribe, conflict string) *pb.ImportSubscribeResult {
+	result := &pb.ImportSubscribeResult{Title: item.Title, Result: model.ImportFailed}
+	rec := newAuditRecord(authUser, model.AuditImportSubscribe, 0)
+	var err error
+	defer func() {
+		if err != nil {
+			log.Errorf("import subscribe %q err: %v", item.Title, err)
+			result.Reason = err.Error()
+		}
+		if result.Result != model.ImportSkipped {
+			rec.record(err)
+		}
+	}()
+
+	existing := model.Subscribe{}
+	found := model.DB().Where("tenant_id = ? AND user_id = ? AND title = ?", authUser.Tenant, authUser.ID, item.Title).
+		Limit(1).Find(&existing).RowsAffected != 0
+	if found && conflict == model.ImportSkip {
+		result.Id, result.ImportedTitle, result.Result = uint64(existing.ID), existing.Title, model.ImportSkipped
+		return result
+	}
+	if len(item.Entities) != 0 {
+		var skipped []model.EntityResult
+		if item.Entities, skipped, err = s.lookupEntities(item.Entities, authUser.ID, authUser.Token, authUser.Auth); err != nil {
+			return result
+		}
+		result.SkippedEntities = entityResults(0, skipped)
+	}
+	target := &model.Subscribe{TenantID: authUser.Tenant, UserID: authUser.ID}
+	if found && conflict == model.ImportOverwrite {
+		target = &existing
+	} else if err = model.CheckSubscribeQuota(authUser.Tenant, authUser.ID); err != nil {
+		return result
+	}
+	if err = model.CheckEntitiesQuota(target, item.Entities); err != nil {
+		return result
+	}
+
+	subscribe, imported, err := model.ImportSubscribe(authUser.Tenant, authUser.ID, item, conflict)
+	if err != nil {
+		return result
+	}
+	result.Id, result.ImportedTitle, result.Result = uint64(subscribe.ID), subscribe.Title, imported
+	rec.subscribeID, rec.entityIDs, rec.after = subscribe.ID, item.Entities, item
+	if found && imported == model.ImportOverwritten {
+		rec.before = snapshotSubscribe(&existing)
+	}
+	for i := range result.SkippedEntities {
+		result.SkippedEntities[i].SubscribeId = uint64(subscribe.ID)
+	}
+
+	selectors, listErr := model.ListSelectors(subscribe.ID)
+	if listErr != nil {
+		log.Error("err:", listErr)
+		return result
+	}
+	for i := range selectors {
+		result.Selectors = append(result.Selectors, s.syncSelector(subscribe, &selectors[i], authUser.Token, authUser.Auth, authUser.ID))
+	}
+	return result
+}
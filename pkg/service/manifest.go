@@ -0,0 +1,351 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core-broker/api/subscribe/v1"
+	"github.com/tkeel-io/core-broker/pkg/auth"
+	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	manifestDirEnvKey          = "MANIFEST_DIR"
+	manifestSyncIntervalEnvKey = "MANIFEST_SYNC_INTERVAL"
+	manifestTenantsEnvKey      = "MANIFEST_TENANTS"
+	// anyManifestTenant in MANIFEST_TENANTS allows the manifests of any tenant.
+	anyManifestTenant = "*"
+	// DefaultManifestSyncInterval is how often the manifests are applied when MANIFEST_SYNC_INTERVAL is not set.
+	DefaultManifestSyncInterval = 30 * time.Second
+)
+
+// ManifestDir returns the directory of the subscription manifests, empty applies no manifest.
+func ManifestDir() string {
+	return os.Getenv(manifestDirEnvKey)
+}
+
+// ManifestSyncInterval returns how often the manifests are applied, zero applies them on startup only.
+func ManifestSyncInterval() (time.Duration, error) {
+	env := os.Getenv(manifestSyncIntervalEnvKey)
+	if env == "" {
+		return DefaultManifestSyncInterval, nil
+	}
+	interval, err := time.ParseDuration(env)
+	if err != nil || interval < 0 {
+		return 0, errors.Errorf("invalid %s %q, want a non-negative duration like \"30s\"", manifestSyncIntervalEnvKey, env)
+	}
+	return interval, nil
+}
+
+// ManifestTenants returns the tenants the manifests may declare subscriptions of, from the comma separated
+// MANIFEST_TENANTS. A manifest decides the owner of its subscriptions, so the manifests of the other tenants
+// are rejected.
+func ManifestTenants() []string {
+	tenants := make([]string, 0)
+	for _, tenant := range strings.Split(os.Getenv(manifestTenantsEnvKey), ",") {
+		if tenant = strings.TrimSpace(tenant); tenant != "" {
+			tenants = append(tenants, tenant)
+		}
+	}
+	return tenants
+}
+
+// manifestStatus is the outcome of the last apply of a manifest file.
+type manifestStatus struct {
+	tenant string
+	status *pb.ManifestStatus
+}
+
+// manifestWatcher applies the manifests of a directory and keeps the outcome of each file.
+type manifestWatcher struct {
+	dir      string
+	interval time.Duration
+	tenants  map[string]bool
+
+	mu     sync.RWMutex
+	status map[string]manifestStatus
+}
+
+func newManifestWatcher(dir string, interval time.Duration, tenants []string) *manifestWatcher {
+	if len(tenants) == 0 {
+		log.Warnf("%s is empty, every manifest of %s is rejected", manifestTenantsEnvKey, dir)
+	}
+	w := &manifestWatcher{dir: dir, interval: interval, tenants: make(map[string]bool, len(tenants)), status: make(map[string]manifestStatus)}
+	for _, tenant := range tenants {
+		w.tenants[tenant] = true
+	}
+	return w
+}
+
+// allowed tells whether the manifests may declare subscriptions of the tenant.
+func (w *manifestWatcher) allowed(tenant string) bool {
+	return w.tenants[anyManifestTenant] || w.tenants[tenant]
+}
+
+// runManifests applies the manifests on startup and then every interval until ctx is done, so a changed
+// manifest is applied and a drifted subscription is put back as declared.
+func (s *SubscribeService) runManifests(ctx context.Context) {
+	w := s.manifests
+	s.applyManifests()
+	if w.interval == 0 {
+		log.Info("manifests are applied on startup only")
+		return
+	}
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		s.applyManifests()
+	}
+}
+
+// applyManifests applies every manifest of the directory and releases the subscriptions of the removed ones.
+func (s *SubscribeService) applyManifests() {
+	w := s.manifests
+	files, err := manifestFiles(w.dir)
+	if err != nil {
+		log.Errorf("list manifests of %s err: %v", w.dir, err)
+		return
+	}
+	present := make(map[string]bool, len(files))
+	for _, file := range files {
+		present[file] = true
+		s.applyManifest(file)
+	}
+
+	managed, err := model.ManagedManifests()
+	if err != nil {
+		log.Error("err:", err)
+		return
+	}
+	for _, file := range managed {
+		if present[file] {
+			continue
+		}
+		released, err := model.ReleaseManifest(file, nil)
+		if err != nil {
+			log.Errorf("release subscribes of manifest %s err: %v", file, err)
+			continue
+		}
+		log.Infof("manifest %s removed, released %d subscribes", file, len(released))
+	}
+	w.mu.Lock()
+	for file := range w.status {
+		if !present[file] {
+			delete(w.status, file)
+		}
+	}
+	w.mu.Unlock()
+}
+
+// applyManifest applies the subscriptions of a manifest file one by one. The subscriptions the file no
+// longer declares are released, unless a subscription failed to apply.
+func (s *SubscribeService) applyManifest(file string) {
+	w := s.manifests
+	now := time.Now()
+	status := &pb.ManifestStatus{File: file, AppliedAt: now.Unix()}
+	tenant := ""
+	defer func() {
+		w.mu.Lock()
+		w.status[file] = manifestStatus{tenant: tenant, status: status}
+		w.mu.Unlock()
+	}()
+
+	manifest, err := w.readManifest(file)
+	if err != nil {
+		log.Errorf("read manifest %s err: %v", file, err)
+		status.Error = err.Error()
+		return
+	}
+	tenant, status.User = manifest.Tenant, manifest.User
+	status.Subscribes = s.applyManifestSubscribes(file, manifest, w.lastDrift(file), now)
+}
+
+// readManifest reads and decodes the manifest file. The file must not be writable by group or others and
+// its tenant must be allowed, since the manifest decides the owner of its subscriptions.
+func (w *manifestWatcher) readManifest(file string) (*model.Manifest, error) {
+	path := filepath.Join(w.dir, file)
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "stat manifest")
+	}
+	if perm := info.Mode().Perm(); perm&0022 != 0 {
+		return nil, errors.Errorf("manifest is writable by group or others (%s)", perm)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read manifest")
+	}
+	manifest, err := model.DecodeManifest(data)
+	if err != nil {
+		return nil, err
+	}
+	if !w.allowed(manifest.Tenant) {
+		return nil, errors.Errorf("tenant %q is not in %s", manifest.Tenant, manifestTenantsEnvKey)
+	}
+	return manifest, nil
+}
+
+func (s *SubscribeService) applyManifestSubscribes(file string, manifest *model.Manifest, lastDrift map[string]*pb.ManifestSubscribeStatus, now time.Time) []*pb.ManifestSubscribeStatus {
+	results := make([]*pb.ManifestSubscribeStatus, 0, len(manifest.Subscribes))
+	keep := make([]uint, 0, len(manifest.Subscribes))
+	failed := false
+	for i := range manifest.Subscribes {
+		item := &manifest.Subscribes[i]
+		result := s.applyManifestSubscribe(file, manifest, item)
+		if result.Result == model.ManifestFailed {
+			failed = true
+		}
+		if len(result.Drift) != 0 {
+			result.DriftedAt = now.Unix()
+		} else if last, ok := lastDrift[item.Title]; ok {
+			result.Drift, result.DriftedAt = last.Drift, last.DriftedAt
+		}
+		if result.Id != 0 {
+			keep = append(keep, uint(result.Id))
+		}
+		results = append(results, result)
+	}
+	if failed {
+		return results
+	}
+	released, err := model.ReleaseManifest(file, keep)
+	if err != nil {
+		log.Errorf("release subscribes of manifest %s err: %v", file, err)
+		return results
+	}
+	for i := range released {
+		log.Infof("subscribe %d released by manifest %s", released[i].ID, file)
+	}
+	return results
+}
+
+// applyManifestSubscribe applies a subscription of a manifest on behalf of its owner. The manifest is trusted
+// configuration, its entities are not looked up in core, but the quotas of its owner apply as on import. A change
+// is audited as made by the broker and the selectors of the subscription are synced right away.
+func (s *SubscribeService) applyManifestSubscribe(file string, manifest *model.Manifest, item *model.PortableSubscribe) *pb.ManifestSubscribeStatus {
+	result := &pb.ManifestSubscribeStatus{Title: item.Title}
+	var subscribe *model.Subscribe
+	applied := model.ManifestApply{Title: item.Title, Result: model.ManifestFailed}
+	var err error
+	if applied.SubscribeID, err = checkManifestQuota(manifest, item); err == nil {
+		subscribe, applied, err = model.ApplyManifestSubscribe(file, manifest.Tenant, manifest.User, item)
+	}
+	result.Id, result.Result, result.Drift = uint64(applied.SubscribeID), applied.Result, applied.Drift
+	if err != nil {
+		log.Errorf("apply subscribe %q of manifest %s err: %v", item.Title, file, err)
+		result.Error = err.Error()
+	}
+	if len(applied.Drift) != 0 {
+		log.Warnf("subscribe %q of manifest %s drifted: %s", item.Title, file, strings.Join(applied.Drift, ", "))
+	}
+	if applied.Result == model.ManifestUnchanged {
+		return result
+	}
+
+	rec := newAuditRecord(auth.User{Tenant: manifest.Tenant, ID: model.AuditSystemActor}, model.AuditApplyManifest, applied.SubscribeID)
+	rec.entityIDs, rec.after = item.Entities, applied
+	rec.record(err)
+	if err != nil {
+		return result
+	}
+
+	selectors, err := model.ListSelectors(subscribe.ID)
+	if err != nil {
+		log.Error("err:", err)
+		return result
+	}
+	for i := range selectors {
+		s.syncSelector(subscribe, &selectors[i], "", userAuth(manifest.Tenant, manifest.User), model.AuditSystemActor)
+	}
+	return result
+}
+
+// checkManifestQuota checks the quotas of the owner of the manifest for its subscription as importSubscribe does,
+// and returns the ID of the subscription when it exists.
+func checkManifestQuota(manifest *model.Manifest, item *model.PortableSubscribe) (uint, error) {
+	target := &model.Subscribe{TenantID: manifest.Tenant, UserID: manifest.User}
+	existing := model.Subscribe{}
+	err := model.DB().Where("tenant_id = ? AND user_id = ? AND title = ?", manifest.Tenant, manifest.User, item.Title).
+		Limit(1).Find(&existing).Error
+	if err != nil {
+		return 0, errors.Wrap(err, "query subscribe by title")
+	}
+	if existing.ID != 0 {
+		target = &existing
+	} else if err = model.CheckSubscribeQuota(manifest.Tenant, manifest.User); err != nil {
+		return 0, err
+	}
+	return existing.ID, model.CheckEntitiesQuota(target, item.Entities)
+}
+
+// lastDrift returns the subscriptions of the last apply of the manifest file by title.
+func (w *manifestWatcher) lastDrift(file string) map[string]*pb.ManifestSubscribeStatus {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	last := make(map[string]*pb.ManifestSubscribeStatus)
+	if status, ok := w.status[file]; ok {
+		for _, sub := range status.status.Subscribes {
+			if len(sub.Drift) != 0 {
+				last[sub.Title] = sub
+			}
+		}
+	}
+	return last
+}
+
+// manifestFiles returns the YAML files of the directory, the hidden files, like the ones of a mounted
+// ConfigMap, are skipped.
+func manifestFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "read manifest dir")
+	}
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") || entry.IsDir() {
+			continue
+		}
+		if ext := filepath.Ext(name); ext == ".yaml" || ext == ".yml" {
+			files = append(files, name)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// ListManifests returns the outcome of the last apply of the manifests declaring subscriptions of the user.
+func (s *SubscribeService) ListManifests(ctx context.Context, req *pb.ListManifestsRequest) (*pb.ListManifestsResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	resp := &pb.ListManifestsResponse{Manifests: make([]*pb.ManifestStatus, 0)}
+	if s.manifests == nil {
+		return resp, nil
+	}
+	s.manifests.mu.RLock()
+	defer s.manifests.mu.RUnlock()
+	for _, status := range s.manifests.status {
+		if status.tenant == authUser.Tenant && status.status.User == authUser.ID {
+			resp.Manifests = append(resp.Manifests, status.status)
+		}
+	}
+	sort.Slice(resp.Manifests, func(i, j int) bool {
+		return resp.Manifests[i].File < resp.Manifests[j].File
+	})
+	return resp, nil
+}
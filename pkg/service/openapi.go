@@ -18,9 +18,13 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	v1 "github.com/tkeel-io/core-broker/api/openapi/v1"
+	"github.com/tkeel-io/core-broker/pkg/model"
 	"github.com/tkeel-io/core-broker/pkg/util"
+	"github.com/tkeel-io/kit/log"
 	openapi_v1 "github.com/tkeel-io/tkeel-interface/openapi/v1"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -63,16 +67,60 @@ func (s *OpenapiService) Status(ctx context.Context, in *emptypb.Empty) (*openap
 	}, nil
 }
 
+// tenantExtra is the extra data of TenantEnableRequest, a JSON object like {"user_id": "admin"} naming the
+// admin of the tenant. tKeel passes the extra given when the plugin is enabled for the tenant as it is.
+type tenantExtra struct {
+	UserID string `json:"user_id"`
+}
+
 // TenantEnable implements TenantEnable.OpenapiServer.
+// The default subscribe of the tenant admin is provisioned right away. Enabling the tenant does not depend on
+// it, so without an admin in the extra the tenant is enabled, a warning is logged and the message of the
+// result tells the default subscribe is not provisioned.
 func (s *OpenapiService) TenantEnable(ctx context.Context, in *openapi_v1.TenantEnableRequest) (*openapi_v1.TenantEnableResponse, error) {
+	if in.TenantId == "" {
+		return &openapi_v1.TenantEnableResponse{Res: util.BadRequestResult("tenant id is empty")}, nil
+	}
+	extra := tenantExtra{}
+	if len(in.Extra) != 0 {
+		if err := json.Unmarshal(in.Extra, &extra); err != nil {
+			log.Error("parse tenant extra err:", err)
+			return &openapi_v1.TenantEnableResponse{Res: util.BadRequestResult("invalid extra")}, nil
+		}
+	}
+	if extra.UserID == "" {
+		// the default subscribe of the admin is then created on the first ListSubscribe, as for the other users
+		log.Warnf("tenant %s enabled without extra.user_id, default subscribe of the admin not provisioned", in.TenantId)
+		res := util.OKResult()
+		res.Msg = "default subscribe not provisioned, extra.user_id is empty"
+		return &openapi_v1.TenantEnableResponse{Res: res}, nil
+	}
+
+	sub, created, err := model.EnsureDefaultSubscribe(in.TenantId, extra.UserID, _DefaultSubscribeTitle, _DefaultSubscribeDescription)
+	if err != nil {
+		log.Error("provision default subscribe err:", err)
+		return &openapi_v1.TenantEnableResponse{Res: util.InternalErrorResult(err.Error())}, nil
+	}
+	log.Infof("tenant %s enabled, default subscribe %d (created: %t)", in.TenantId, sub.ID, created)
 	return &openapi_v1.TenantEnableResponse{
 		Res: util.OKResult(),
 	}, nil
 }
 
 // TenantDisable implements TenantDisable.OpenapiServer.
+// The entities of the tenant are unsubscribed and its subscribes archived, the summary is the message of the result.
 func (s *OpenapiService) TenantDisable(ctx context.Context, in *openapi_v1.TenantDisableRequest) (*openapi_v1.TenantDisableResponse, error) {
+	if in.TenantId == "" {
+		return &openapi_v1.TenantDisableResponse{Res: util.BadRequestResult("tenant id is empty")}, nil
+	}
+	summary, err := model.DisableTenant(in.TenantId)
+	if err != nil {
+		log.Error("disable tenant err:", err)
+		return &openapi_v1.TenantDisableResponse{Res: util.InternalErrorResult(err.Error())}, nil
+	}
+	res := util.OKResult()
+	res.Msg = fmt.Sprintf("archived %d subscribes, unsubscribed %d entities", summary.Subscribes, summary.Entities)
 	return &openapi_v1.TenantDisableResponse{
-		Res: util.OKResult(),
+		Res: res,
 	}, nil
 }
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/tkeel-io/core-broker/api/subscribe/v1"
+	"github.com/tkeel-io/core-broker/pkg/auth"
+	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/kit/log"
+)
+
+// PauseSubscribe stops the deliveries to the endpoint of the subscription and keeps its entities, their core
+// subscriptions are removed by the OutboxDispatcher.
+func (s *SubscribeService) PauseSubscribe(ctx context.Context, req *pb.PauseSubscribeRequest) (_ *pb.PauseSubscribeResponse, err error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	rec := newAuditRecord(authUser, model.AuditPauseSubscribe, uint(req.Id))
+	defer func() { rec.record(err) }()
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+
+	rec.before = snapshotSubscribe(&subscribe)
+	paused, err := model.PauseSubscribe(&subscribe)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	rec.after = snapshotSubscribe(&subscribe)
+
+	return &pb.PauseSubscribeResponse{
+		Id:       uint64(subscribe.ID),
+		Status:   subscribe.Status,
+		Entities: uint64(paused),
+	}, nil
+}
+
+// ResumeSubscribe restores the deliveries to the endpoint of the paused subscription, the core subscriptions
+// of its entities are restored by the OutboxDispatcher.
+func (s *SubscribeService) ResumeSubscribe(ctx context.Context, req *pb.ResumeSubscribeRequest) (_ *pb.ResumeSubscribeResponse, err error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	rec := newAuditRecord(authUser, model.AuditResumeSubscribe, uint(req.Id))
+	defer func() { rec.record(err) }()
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+
+	rec.before = snapshotSubscribe(&subscribe)
+	resumed, err := model.ResumeSubscribe(&subscribe)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	rec.after = snapshotSubscribe(&subscribe)
+
+	return &pb.ResumeSubscribeResponse{
+		Id:       uint64(subscribe.ID),
+		Status:   subscribe.Status,
+		Entities: uint64(resumed),
+	}, nil
+}
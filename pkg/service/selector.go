@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core-broker/api/subscribe/v1"
+	"github.com/tkeel-io/core-broker/pkg/auth"
+	"github.com/tkeel-io/core-broker/pkg/deviceutil"
+	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+)
+
+const (
+	selectorSyncIntervalEnvKey = "SELECTOR_SYNC_INTERVAL"
+	// DefaultSelectorSyncInterval is how often the selectors are synced when SELECTOR_SYNC_INTERVAL is not set.
+	DefaultSelectorSyncInterval = 5 * time.Minute
+)
+
+// SelectorSyncInterval returns how often the selectors are synced, zero syncs them on request only.
+func SelectorSyncInterval() (time.Duration, error) {
+	env := os.Getenv(selectorSyncIntervalEnvKey)
+	if env == "" {
+		return DefaultSelectorSyncInterval, nil
+	}
+	interval, err := time.ParseDuration(env)
+	if err != nil || interval < 0 {
+		return 0, errors.Errorf("invalid %s %q, want a non-negative duration like \"5m\"", selectorSyncIntervalEnvKey, env)
+	}
+	return interval, nil
+}
+
+// runSelectorSync syncs all the selectors every interval until ctx is done, on behalf of the owners of
+// their subscriptions.
+func (s *SubscribeService) runSelectorSync(ctx context.Context, interval time.Duration) {
+	if interval == 0 {
+		log.Info("selectors are synced on request only")
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		selectors, err := model.ActiveSelectors()
+		if err != nil {
+			log.Error("list selectors err:", err)
+			continue
+		}
+		for i := range selectors {
+			subscribe := model.Subscribe{}
+			if err = model.DB().First(&subscribe, selectors[i].SubscribeID).Error; err != nil {
+				log.Errorf("query subscribe of selector %d err: %v", selectors[i].ID, err)
+				continue
+			}
+			s.syncSelector(&subscribe, &selectors[i], "", userAuth(subscribe.TenantID, subscribe.UserID), model.AuditSystemActor)
+		}
+	}
+}
+
+// syncSelector resolves the devices of the selector and syncs the subscription with them. A failure is kept
+// on the selector and returned in the result. Any change is audited as made by actor.
+func (s *SubscribeService) syncSelector(subscribe *model.Subscribe, selector *model.Selector, token, auth, actor string) *pb.SelectorSyncResult {
+	result := &pb.SelectorSyncResult{SelectorId: uint64(selector.ID)}
+	ids, err := s.resolveSelector(selector, token, auth)
+	if err == nil {
+		err = model.CheckEntitiesQuota(subscribe, ids)
+	}
+	if err == nil {
+		var synced model.SelectorSync
+		if synced, err = model.SyncSelector(selector, ids); err == nil {
+			result.Attached, result.Detached = synced.Attached, synced.Detached
+		}
+	}
+	if err != nil {
+		log.Errorf("sync selector %d of subscribe %d err: %v", selector.ID, subscribe.ID, err)
+		result.Error = err.Error()
+		if recordErr := model.RecordSelectorError(selector, err); recordErr != nil {
+			log.Error("err:", recordErr)
+		}
+	}
+	if err == nil && len(result.Attached) == 0 && len(result.Detached) == 0 {
+		return result
+	}
+
+	e, auditErr := model.NewAuditEvent(subscribe.TenantID, actor, model.AuditSyncSelector, subscribe.ID, nil, result, err)
+	if auditErr == nil {
+		auditErr = model.RecordAuditEvent(e, append(append([]string{}, result.Attached...), result.Detached...))
+	}
+	if auditErr != nil {
+		log.Errorf("record audit event %s of subscribe %d err: %v", model.AuditSyncSelector, subscribe.ID, auditErr)
+	}
+	return result
+}
+
+// resolveSelector returns the devices the selector selects now.
+func (s *SubscribeService) resolveSelector(selector *model.Selector, token, auth string) ([]string, error) {
+	conditions := deviceutil.Conditions{deviceutil.DeviceTypeQuery()}
+	switch selector.Kind {
+	case model.SelectorGroup:
+		if selector.IncludeSubgroups {
+			conditions = append(conditions, deviceutil.GroupQuery(selector.Value))
+		} else {
+			conditions = append(conditions, deviceutil.ParentQuery(selector.Value))
+		}
+	case model.SelectorTemplate:
+		conditions = append(conditions, deviceutil.TemplateQuery(selector.Value))
+	case model.SelectorConditions:
+		selected := deviceutil.Conditions{}
+		if err := json.Unmarshal([]byte(selector.Conditions), &selected); err != nil {
+			return nil, errors.Wrap(err, "decode selector conditions")
+		}
+		conditions = append(conditions, selected...)
+	default:
+		return nil, errors.Wrapf(model.ErrInvalidSelector, "unknown kind %q", selector.Kind)
+	}
+
+	devices, err := s.searchDevices(conditions, "", token, auth)
+	if err != nil {
+		return nil, errors.Wrap(err, "query devices of selector")
+	}
+	ids := make([]string, 0, len(devices))
+	for _, device := range devices {
+		ids = append(ids, device.Id)
+	}
+	return ids, nil
+}
+
+func (s *SubscribeService) CreateSubscribeSelector(ctx context.Context, req *pb.CreateSubscribeSelectorRequest) (_ *pb.CreateSubscribeSelectorResponse, err error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	rec := newAuditRecord(authUser, model.AuditCreateSelector, uint(req.Id))
+	defer func() { rec.record(err) }()
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	if subscribe.Managed {
+		return nil, pb.ErrSubscribeManaged()
+	}
+
+	selector := model.Selector{
+		TenantID:         authUser.Tenant,
+		SubscribeID:      subscribe.ID,
+		Kind:             req.Kind,
+		Value:            req.Value,
+		IncludeSubgroups: req.IncludeSubgroups,
+	}
+	if len(req.Conditions) != 0 {
+		conditions := make(deviceutil.Conditions, 0, len(req.Conditions))
+		for _, c := range req.Conditions {
+			if c.Field == "" || c.Operator == "" {
+				err = errors.Wrapf(model.ErrInvalidSelector, "condition %+v without field or operator", c)
+				log.Error("err:", err)
+				return nil, pb.ErrInvalidArgument()
+			}
+			conditions = append(conditions, deviceutil.NewQuery(c.Field, c.Operator, c.Value))
+		}
+		encoded, _ := json.Marshal(conditions)
+		selector.Conditions = string(encoded)
+	}
+	if err = selector.Validate(); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInvalidArgument()
+	}
+	if err = model.DB().Create(&selector).Error; err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+
+	resp := &pb.CreateSubscribeSelectorResponse{
+		Sync: s.syncSelector(&subscribe, &selector, authUser.Token, authUser.Auth, authUser.ID),
+	}
+	counts, err := model.SelectorEntityCounts(subscribe.ID)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalQuery()
+	}
+	resp.Selector = selectorToPB(&selector, counts[selector.ID])
+	rec.after = resp.Selector
+	return resp, nil
+}
+
+func (s *SubscribeService) ListSubscribeSelectors(ctx context.Context, req *pb.ListSubscribeSelectorsRequest) (*pb.ListSubscribeSelectorsResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+
+	selectors, err := model.ListSelectors(subscribe.ID)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalQuery()
+	}
+	counts, err := model.SelectorEntityCounts(subscribe.ID)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalQuery()
+	}
+	resp := &pb.ListSubscribeSelectorsResponse{Data: make([]*pb.SubscribeSelector, 0, len(selectors))}
+	for i := range selectors {
+		resp.Data = append(resp.Data, selectorToPB(&selectors[i], counts[selectors[i].ID]))
+	}
+	return resp, nil
+}
+
+func (s *SubscribeService) DeleteSubscribeSelector(ctx context.Context, req *pb.DeleteSubscribeSelectorRequest) (_ *pb.DeleteSubscribeSelectorResponse, err error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	rec := newAuditRecord(authUser, model.AuditDeleteSelector, uint(req.Id))
+	defer func() { rec.record(err) }()
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	if subscribe.Managed {
+		return nil, pb.ErrSubscribeManaged()
+	}
+
+	selector := model.Selector{}
+	if err = model.DB().Where("id = ? AND subscribe_id = ?", req.SelectorId, subscribe.ID).First(&selector).Error; err != nil {
+		log.Error("err:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pb.ErrNotFound()
+		}
+		return nil, pb.ErrInternalQuery()
+	}
+	rec.before = selectorToPB(&selector, 0)
+	synced, err := model.DeleteSelector(&selector)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	rec.entityIDs = synced.Detached
+	return &pb.DeleteSubscribeSelectorResponse{
+		Id:   req.Id,
+		Sync: &pb.SelectorSyncResult{SelectorId: req.SelectorId, Attached: synced.Attached, Detached: synced.Detached},
+	}, nil
+}
+
+func (s *SubscribeService) SyncSubscribeSelectors(ctx context.Context, req *pb.SyncSubscribeSelectorsRequest) (*pb.SyncSubscribeSelectorsResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+
+	selectors, err := model.ListSelectors(subscribe.ID)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalQuery()
+	}
+	resp := &pb.SyncSubscribeSelectorsResponse{Id: req.Id, Results: make([]*pb.SelectorSyncResult, 0, len(selectors))}
+	for i := range selectors {
+		resp.Results = append(resp.Results, s.syncSelector(&subscribe, &selectors[i], authUser.Token, authUser.Auth, authUser.ID))
+	}
+	return resp, nil
+}
+
+func selectorToPB(selector *model.Selector, entities int64) *pb.SubscribeSelector {
+	out := &pb.SubscribeSelector{
+		Id:               uint64(selector.ID),
+		SubscribeId:      uint64(selector.SubscribeID),
+		Kind:             selector.Kind,
+		Value:            selector.Value,
+		IncludeSubgroups: selector.IncludeSubgroups,
+		Entities:         entities,
+		LastError:        selector.LastError,
+	}
+	if selector.LastSyncedAt != nil {
+		out.LastSyncedAt = selector.LastSyncedAt.Unix()
+	}
+	if selector.Conditions != "" {
+		conditions := deviceutil.Conditions{}
+		if err := json.Unmarshal([]byte(selector.Conditions), &conditions); err != nil {
+			log.Errorf("decode conditions of selector %d err: %v", selector.ID, err)
+		}
+		for _, c := range conditions {
+			out.Conditions = append(out.Conditions, &pb.SelectorCondition{Field: c.Field, Operator: c.Operator, Value: c.Value})
+		}
+	}
+	return out
+}
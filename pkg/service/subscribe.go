@@ -3,9 +3,8 @@ package service
 import (
 	"context"
 	"strconv"
-	"strings"
+	"time"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/tkeel-io/core-broker/pkg/auth"
 
 	"github.com/pkg/errors"
@@ -13,7 +12,6 @@ import (
 	"github.com/tkeel-io/core-broker/pkg/deviceutil"
 	"github.com/tkeel-io/core-broker/pkg/model"
 	"github.com/tkeel-io/core-broker/pkg/pagination"
-	"github.com/tkeel-io/core-broker/pkg/subscribeuril"
 	"github.com/tkeel-io/kit/log"
 	"gorm.io/gorm"
 )
@@ -21,6 +19,10 @@ import (
 const (
 	SuccessStatus     = "SUCCESS"
 	ErrPartialFailure = "PARTIAL FAILURE"
+	ErrFailure        = "FAILURE"
+
+	entityOnline  = "online"
+	entityOffline = "offline"
 
 	_DefaultSubscribeTitle       = "我的订阅"
 	_DefaultSubscribeDescription = "这是我的默认订阅，该订阅无法被删除，无法被修改。"
@@ -32,29 +34,90 @@ var (
 
 type SubscribeService struct {
 	pb.UnimplementedSubscribeServer
+	// retention is how long deleted subscribes are kept, zero keeps them forever.
+	retention time.Duration
+	// outboxRetention is how long the done outbox operations are kept, zero keeps them forever.
+	outboxRetention time.Duration
+	// selectorSyncInterval is how often the selectors are synced, zero syncs them on request only.
+	selectorSyncInterval time.Duration
+	// manifests applies the subscription manifests, nil when no manifest directory is set.
+	manifests *manifestWatcher
 }
 
 func NewSubscribeService() *SubscribeService {
 	if err := model.Setup(); err != nil {
 		log.Fatal(err)
 	}
+	retention, err := model.SubscribeRetention()
+	if err != nil {
+		log.Fatal(err)
+	}
+	outboxRetention, err := model.OutboxRetention()
+	if err != nil {
+		log.Fatal(err)
+	}
+	selectorSyncInterval, err := SelectorSyncInterval()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var manifests *manifestWatcher
+	if dir := ManifestDir(); dir != "" {
+		interval, err := ManifestSyncInterval()
+		if err != nil {
+			log.Fatal(err)
+		}
+		manifests = newManifestWatcher(dir, interval, ManifestTenants())
+	}
+
+	go func() {
+		if err := model.MigrateSubscribeAddr(); err != nil {
+			log.Error("migrate entities subscribeAddr err:", err)
+		}
+		if err := model.MigrateCoreSubscriptions(); err != nil {
+			log.Error("migrate core subscriptions err:", err)
+		}
+	}()
+
+	return &SubscribeService{
+		retention:            retention,
+		outboxRetention:      outboxRetention,
+		selectorSyncInterval: selectorSyncInterval,
+		manifests:            manifests,
+	}
+}
 
-	return &SubscribeService{}
+// Run dispatches the core operations recorded in the outbox, redelivers the events endpoints failed to receive,
+// purges the deleted subscribes and the done operations out of retention, syncs the selectors and applies the
+// subscription manifests.
+func (s *SubscribeService) Run() {
+	ctx := context.Background()
+	go model.NewRetentionPurger(s.retention).Run(ctx)
+	go model.NewOutboxPurger(s.outboxRetention).Run(ctx)
+	go model.NewRedeliverer().Run(ctx)
+	go s.runSelectorSync(ctx, s.selectorSyncInterval)
+	if s.manifests != nil {
+		go s.runManifests(ctx)
+	}
+	model.NewOutboxDispatcher().Run(ctx)
 }
 
-func (s *SubscribeService) SubscribeEntitiesByIDs(ctx context.Context, req *pb.SubscribeEntitiesByIDsRequest) (*pb.SubscribeEntitiesByIDsResponse, error) {
+func (s *SubscribeService) SubscribeEntitiesByIDs(ctx context.Context, req *pb.SubscribeEntitiesByIDsRequest) (_ *pb.SubscribeEntitiesByIDsResponse, err error) {
 	authUser, err := auth.GetUser(ctx)
 	if nil != err {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID}
-	validateSubscribeResult := model.DB().First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	rec := newAuditRecord(authUser, model.AuditSubscribeEntities, uint(req.Id))
+	defer func() { rec.record(err) }()
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
+	if subscribe.Managed {
+		return nil, pb.ErrSubscribeManaged()
+	}
 
 	resp := &pb.SubscribeEntitiesByIDsResponse{
 		Id:     req.GetId(),
@@ -64,47 +127,140 @@ func (s *SubscribeService) SubscribeEntitiesByIDs(ctx context.Context, req *pb.S
 		return resp, nil
 	}
 
-	records := s.createSubscribeEntitiesRecords(req.Entities, &subscribe)
-	err = CreateSubscribeEntities(records)
+	rec.entityIDs = req.Entities
+	found, skipped, err := s.lookupEntities(req.Entities, authUser.ID, authUser.Token, authUser.Auth)
 	if err != nil {
-		return nil, err
+		log.Error("err:", err)
+		return nil, pb.ErrInternalQuery()
 	}
+	if err = model.CheckEntitiesQuota(&subscribe, found); err != nil {
+		return nil, quotaError(err)
+	}
+	resp.Summary, resp.Results, resp.Status = s.subscribeEntities(&subscribe, found, skipped...)
+	rec.after, rec.err = resp.Summary, statusError(resp.Status)
 	return resp, nil
 }
 
-func CreateSubscribeEntities(records []*model.SubscribeEntities) (err error) {
-	var affected int64
-	for _, record := range records {
-		result := model.DB().Preload("Subscribe").Create(record)
-		if result.Error != nil {
-			log.Error("err:", result.Error)
-			mysqlErr, ok := result.Error.(*mysql.MySQLError)
-			if ok && mysqlErr.Number == 1062 {
-				continue
-			}
-			//			return pb.ErrInternalError()
+// subscribeEntities adds the entities to subscribe through the bulk pipeline and reports its summary, the result
+// of every entity and the status. skipped are the results of the requested entities which were not subscribed.
+func (s *SubscribeService) subscribeEntities(subscribe *model.Subscribe, entityIDs []string, skipped ...model.EntityResult) (*pb.BulkSubscribeSummary, []*pb.EntityResult, string) {
+	summary, results, err := model.SubscribeEntitiesInBatches(subscribe, entityIDs, model.DefaultBulkBatchSize, func(progress model.BulkSummary) {
+		log.Infof("subscribe %d: %d/%d entities processed", subscribe.ID, progress.Processed(), progress.Total)
+	})
+	if err != nil {
+		log.Error("err:", err)
+	}
+	for _, result := range skipped {
+		summary.Total++
+		summary.Add(result)
+		results = append(results, result)
+	}
+	status := SuccessStatus
+	if !summary.Succeeded() {
+		status = ErrPartialFailure
+	}
+	return bulkSubscribeSummary(summary), entityResults(subscribe.ID, results), status
+}
+
+// planSubscribeEntities computes, without changing anything, what subscribing the entities would do and the quota
+// usage it would lead to.
+func (s *SubscribeService) planSubscribeEntities(subscribe *model.Subscribe, entityIDs []string) (*pb.BulkSubscribeSummary, []*pb.EntityResult, *pb.QuotaProjection, error) {
+	summary, results, err := model.PlanSubscribeEntities(subscribe, entityIDs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	projection, err := model.ProjectEntitiesQuota(subscribe, entityIDs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return bulkSubscribeSummary(summary), entityResults(subscribe.ID, results), quotaProjection(projection), nil
+}
+
+func quotaProjection(projection model.QuotaProjection) *pb.QuotaProjection {
+	quota := model.CurrentQuota()
+	return &pb.QuotaProjection{
+		SubscribeEntities:      projection.SubscribeEntities,
+		SubscribeEntitiesLimit: quota.EntitiesPerSubscribe,
+		TenantEntities:         projection.TenantEntities,
+		TenantEntitiesLimit:    quota.EntitiesPerTenant,
+		Exceeded:               projection.Exceeded,
+	}
+}
+
+func bulkSubscribeSummary(summary model.BulkSummary) *pb.BulkSubscribeSummary {
+	return &pb.BulkSubscribeSummary{
+		Total:     uint64(summary.Total),
+		Created:   uint64(summary.Created),
+		Existing:  uint64(summary.Existing),
+		Failed:    uint64(summary.Failed),
+		NotFound:  uint64(summary.NotFound),
+		Forbidden: uint64(summary.Forbidden),
+	}
+}
+
+func bulkUnsubscribeSummary(results []model.EntityResult) *pb.BulkUnsubscribeSummary {
+	summary := &pb.BulkUnsubscribeSummary{Total: uint64(len(results))}
+	for _, result := range results {
+		if result.Result == model.ResultRemoved {
+			summary.Removed++
+		} else {
+			summary.NotSubscribed++
 		}
-		affected += 1
 	}
-	if affected != int64(len(records)) {
-		log.Warn(pb.ErrSomeDuplicateCreate())
+	return summary
+}
+
+func entityResults(subscribeID uint, results []model.EntityResult) []*pb.EntityResult {
+	items := make([]*pb.EntityResult, 0, len(results))
+	for _, result := range results {
+		items = append(items, &pb.EntityResult{
+			EntityId:    result.EntityID,
+			Result:      result.Result,
+			Reason:      result.Reason,
+			SubscribeId: uint64(subscribeID),
+		})
+	}
+	return items
+}
+
+// statusError returns the error of a status other than SuccessStatus, for the audit log.
+func statusError(status string) error {
+	if status == SuccessStatus {
+		return nil
 	}
-	return nil
+	return errors.New(status)
 }
 
-func (s *SubscribeService) SubscribeEntitiesByGroups(ctx context.Context, req *pb.SubscribeEntitiesByGroupsRequest) (*pb.SubscribeEntitiesByGroupsResponse, error) {
+// quotaError returns the API error of an error checking the quotas.
+func quotaError(err error) error {
+	log.Error("err:", err)
+	if errors.Is(err, model.ErrQuotaExceeded) {
+		return pb.ErrQuotaExceeded()
+	}
+	return pb.ErrInternalError()
+}
+
+func (s *SubscribeService) SubscribeEntitiesByGroups(ctx context.Context, req *pb.SubscribeEntitiesByGroupsRequest) (_ *pb.SubscribeEntitiesByGroupsResponse, err error) {
 	authUser, err := auth.GetUser(ctx)
 	if nil != err {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID}
-	validateSubscribeResult := model.DB().First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	rec := newAuditRecord(authUser, model.AuditSubscribeEntities, uint(req.Id))
+	defer func() {
+		// a dry run changes nothing
+		if !req.DryRun {
+			rec.record(err)
+		}
+	}()
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
+	if subscribe.Managed {
+		return nil, pb.ErrSubscribeManaged()
+	}
 
 	resp := &pb.SubscribeEntitiesByGroupsResponse{
 		Id:     req.Id,
@@ -121,29 +277,44 @@ func (s *SubscribeService) SubscribeEntitiesByGroups(ctx context.Context, req *p
 		log.Debug("no device entities IDs found")
 		return nil, pb.ErrDeviceNotFound()
 	}
-	records := s.createSubscribeEntitiesRecords(ids, &subscribe)
-	log.Info("create subscribe entities records:", records)
-
-	err = CreateSubscribeEntities(records)
-	if err != nil {
-		return nil, err
+	if req.DryRun {
+		resp.DryRun = true
+		if resp.Summary, resp.Results, resp.Quota, err = s.planSubscribeEntities(&subscribe, ids); err != nil {
+			log.Error("err:", err)
+			return nil, pb.ErrInternalQuery()
+		}
+		return resp, nil
+	}
+	rec.entityIDs = ids
+	if err = model.CheckEntitiesQuota(&subscribe, ids); err != nil {
+		return nil, quotaError(err)
 	}
+	resp.Summary, resp.Results, resp.Status = s.subscribeEntities(&subscribe, ids)
+	rec.after, rec.err = resp.Summary, statusError(resp.Status)
 	return resp, nil
 }
 
-func (s *SubscribeService) SubscribeEntitiesByModels(ctx context.Context, req *pb.SubscribeEntitiesByModelsRequest) (*pb.SubscribeEntitiesByModelsResponse, error) {
+func (s *SubscribeService) SubscribeEntitiesByModels(ctx context.Context, req *pb.SubscribeEntitiesByModelsRequest) (_ *pb.SubscribeEntitiesByModelsResponse, err error) {
 	authUser, err := auth.GetUser(ctx)
 	if nil != err {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID}
-	validateSubscribeResult := model.DB().First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	rec := newAuditRecord(authUser, model.AuditSubscribeEntities, uint(req.Id))
+	defer func() {
+		// a dry run changes nothing
+		if !req.DryRun {
+			rec.record(err)
+		}
+	}()
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
+	if subscribe.Managed {
+		return nil, pb.ErrSubscribeManaged()
+	}
 
 	resp := &pb.SubscribeEntitiesByModelsResponse{
 		Id:     req.Id,
@@ -160,67 +331,252 @@ func (s *SubscribeService) SubscribeEntitiesByModels(ctx context.Context, req *p
 		log.Debug("no device entities IDs found")
 		return nil, pb.ErrDeviceNotFound()
 	}
-	records := s.createSubscribeEntitiesRecords(ids, &subscribe)
+	if req.DryRun {
+		resp.DryRun = true
+		if resp.Summary, resp.Results, resp.Quota, err = s.planSubscribeEntities(&subscribe, ids); err != nil {
+			log.Error("err:", err)
+			return nil, pb.ErrInternalQuery()
+		}
+		return resp, nil
+	}
+	rec.entityIDs = ids
+	if err = model.CheckEntitiesQuota(&subscribe, ids); err != nil {
+		return nil, quotaError(err)
+	}
+	resp.Summary, resp.Results, resp.Status = s.subscribeEntities(&subscribe, ids)
+	rec.after, rec.err = resp.Summary, statusError(resp.Status)
+	return resp, nil
+}
+
+func (s *SubscribeService) SubscribeEntitiesByQuery(ctx context.Context, req *pb.SubscribeEntitiesByQueryRequest) (_ *pb.SubscribeEntitiesByQueryResponse, err error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	rec := newAuditRecord(authUser, model.AuditSubscribeEntities, uint(req.Id))
+	defer func() {
+		// a dry run changes nothing
+		if !req.DryRun {
+			rec.record(err)
+		}
+	}()
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	if subscribe.Managed {
+		return nil, pb.ErrSubscribeManaged()
+	}
+	if len(req.Conditions) == 0 && req.Keywords == "" {
+		err = errors.New("neither conditions nor keywords")
+		log.Error("err:", err)
+		return nil, pb.ErrInvalidArgument()
+	}
+	conditions := deviceutil.Conditions{deviceutil.DeviceTypeQuery()}
+	for _, c := range req.Conditions {
+		if c.Field == "" || c.Operator == "" {
+			err = errors.Errorf("condition %+v without field or operator", c)
+			log.Error("err:", err)
+			return nil, pb.ErrInvalidArgument()
+		}
+		conditions = append(conditions, deviceutil.NewQuery(c.Field, c.Operator, c.Value))
+	}
 
-	err = CreateSubscribeEntities(records)
+	devices, err := s.searchDevices(conditions, req.Keywords, authUser.Token, authUser.Auth)
 	if err != nil {
-		return nil, err
+		log.Error("err:", err)
+		return nil, pb.ErrInternalQuery()
+	}
+	if len(devices) == 0 {
+		log.Debug("no device entities IDs found")
+		return nil, pb.ErrDeviceNotFound()
+	}
+	resp := &pb.SubscribeEntitiesByQueryResponse{
+		Id:      req.Id,
+		Status:  SuccessStatus,
+		Devices: make([]*pb.QueriedDevice, 0, len(devices)),
+		DryRun:  req.DryRun,
+	}
+	ids := make([]string, 0, len(devices))
+	for _, device := range devices {
+		ids = append(ids, device.Id)
+		resp.Devices = append(resp.Devices, &pb.QueriedDevice{
+			Id:     device.Id,
+			Name:   device.Properties.BasicInfo.Name,
+			Online: device.Properties.ConnectionInfo.IsOnline,
+		})
 	}
+
+	if req.DryRun {
+		if resp.Summary, resp.Results, resp.Quota, err = s.planSubscribeEntities(&subscribe, ids); err != nil {
+			log.Error("err:", err)
+			return nil, pb.ErrInternalQuery()
+		}
+		return resp, nil
+	}
+	rec.entityIDs = ids
+	if err = model.CheckEntitiesQuota(&subscribe, ids); err != nil {
+		return nil, quotaError(err)
+	}
+	resp.Summary, resp.Results, resp.Status = s.subscribeEntities(&subscribe, ids)
+	rec.after, rec.err = resp.Summary, statusError(resp.Status)
 	return resp, nil
 }
 
-func (s *SubscribeService) UnsubscribeEntitiesByIDs(ctx context.Context, req *pb.UnsubscribeEntitiesByIDsRequest) (*pb.UnsubscribeEntitiesByIDsResponse, error) {
+func (s *SubscribeService) UnsubscribeEntitiesByIDs(ctx context.Context, req *pb.UnsubscribeEntitiesByIDsRequest) (_ *pb.UnsubscribeEntitiesByIDsResponse, err error) {
 	authUser, err := auth.GetUser(ctx)
 	if nil != err {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID}
-	if model.DB().First(&subscribe).RowsAffected == 0 {
-		err = errors.New("subscribe and user ID mismatch")
+	rec := newAuditRecord(authUser, model.AuditUnsubscribeEntities, uint(req.Id))
+	rec.entityIDs = req.Entities
+	defer func() {
+		// a dry run changes nothing
+		if !req.DryRun {
+			rec.record(err)
+		}
+	}()
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-
-	resp := &pb.UnsubscribeEntitiesByIDsResponse{
-		Id:     req.Id,
-		Status: SuccessStatus,
+	if subscribe.Managed {
+		return nil, pb.ErrSubscribeManaged()
 	}
 
-	tx := model.DB().Begin()
-	for _, entityID := range req.Entities {
-		subscribeEntity := model.SubscribeEntities{
-			Subscribe:   subscribe,
-			EntityID:    entityID,
-			SubscribeID: subscribe.ID,
-			UniqueKey:   subscribeuril.GenerateSubscribeTopic(subscribe.ID, entityID),
-		}
-		result := tx.
-			Where("subscribe_id = ?", subscribeEntity.SubscribeID).
-			Where("entity_id = ?", subscribeEntity.EntityID).
-			Where("unique_key = ?", subscribeEntity.UniqueKey).
-			Delete(&subscribeEntity)
-		if result.Error != nil {
-			log.Error("err:", result.Error)
-			tx.Rollback()
-			return nil, pb.ErrInternalError()
+	if req.DryRun {
+		results, err := model.PlanUnsubscribeEntities(&subscribe, req.Entities)
+		if err != nil {
+			log.Error("err:", err)
+			return nil, pb.ErrInternalQuery()
 		}
+		return &pb.UnsubscribeEntitiesByIDsResponse{
+			Id:      req.Id,
+			Status:  SuccessStatus,
+			Summary: bulkUnsubscribeSummary(results),
+			Results: entityResults(subscribe.ID, results),
+			DryRun:  true,
+		}, nil
+	}
+	results, err := model.UnsubscribeEntities(&subscribe, req.Entities)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
 	}
-	tx.Commit()
+	summary := bulkUnsubscribeSummary(results)
+	rec.after = summary
 
-	return resp, nil
+	return &pb.UnsubscribeEntitiesByIDsResponse{
+		Id:      req.Id,
+		Status:  SuccessStatus,
+		Summary: summary,
+		Results: entityResults(subscribe.ID, results),
+	}, nil
 }
 
+func (s *SubscribeService) UnsubscribeEntitiesByGroups(ctx context.Context, req *pb.UnsubscribeEntitiesByGroupsRequest) (_ *pb.UnsubscribeEntitiesByGroupsResponse, err error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	rec := newAuditRecord(authUser, model.AuditUnsubscribeEntities, uint(req.Id))
+	defer func() { rec.record(err) }()
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	if subscribe.Managed {
+		return nil, pb.ErrSubscribeManaged()
+	}
+
+	ids, err := s.getDeviceEntitiesIDsFromGroups(ctx, req.Groups, authUser.Token, authUser.Auth)
+	if err != nil {
+		err = errors.Wrap(err, "get device entities IDs from groups IDs error")
+		log.Error("err:", err)
+		return nil, pb.ErrInternalQuery()
+	}
+	if len(ids) == 0 {
+		log.Debug("no device entities IDs found")
+		return nil, pb.ErrDeviceNotFound()
+	}
+	rec.entityIDs = ids
+	results, err := model.UnsubscribeEntities(&subscribe, ids)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	summary := bulkUnsubscribeSummary(results)
+	rec.after = summary
+
+	return &pb.UnsubscribeEntitiesByGroupsResponse{
+		Id:      req.Id,
+		Status:  SuccessStatus,
+		Summary: summary,
+		Results: entityResults(subscribe.ID, results),
+	}, nil
+}
+
+func (s *SubscribeService) UnsubscribeEntitiesByModels(ctx context.Context, req *pb.UnsubscribeEntitiesByModelsRequest) (_ *pb.UnsubscribeEntitiesByModelsResponse, err error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	rec := newAuditRecord(authUser, model.AuditUnsubscribeEntities, uint(req.Id))
+	defer func() { rec.record(err) }()
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	if subscribe.Managed {
+		return nil, pb.ErrSubscribeManaged()
+	}
+
+	ids, err := s.getDeviceEntitiesIDsFromTemplates(ctx, req.Models, authUser.Token, authUser.Auth)
+	if err != nil {
+		err = errors.Wrap(err, "get device entities IDs from models IDs error")
+		log.Error("err:", err)
+		return nil, pb.ErrInternalQuery()
+	}
+	if len(ids) == 0 {
+		log.Debug("no device entities IDs found")
+		return nil, pb.ErrDeviceNotFound()
+	}
+	rec.entityIDs = ids
+	results, err := model.UnsubscribeEntities(&subscribe, ids)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	summary := bulkUnsubscribeSummary(results)
+	rec.after = summary
+
+	return &pb.UnsubscribeEntitiesByModelsResponse{
+		Id:      req.Id,
+		Status:  SuccessStatus,
+		Summary: summary,
+		Results: entityResults(subscribe.ID, results),
+	}, nil
+}
+
+// ListSubscribeEntities pages through the entities of the subscribe in the order they were subscribed and describes
+// them with one core search per page. With key words or a status the entities are filtered by core, all of them
+// are described before the page is cut.
 func (s *SubscribeService) ListSubscribeEntities(ctx context.Context, req *pb.ListSubscribeEntitiesRequest) (*pb.ListSubscribeEntitiesResponse, error) {
 	authUser, err := auth.GetUser(ctx)
 	if nil != err {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID}
-	validateSubscribeResult := model.DB().First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
@@ -230,19 +586,40 @@ func (s *SubscribeService) ListSubscribeEntities(ctx context.Context, req *pb.Li
 		log.Error("err:", err)
 		return nil, pb.ErrInvalidArgument()
 	}
+	if page.OrderBy != "" && page.OrderBy != "subscribed_at" {
+		log.Error("err:", errors.Errorf("unknown order by %q", page.OrderBy))
+		return nil, pb.ErrInvalidArgument()
+	}
+	if req.Status != "" && req.Status != entityOnline && req.Status != entityOffline {
+		log.Error("err:", errors.Errorf("unknown status %q", req.Status))
+		return nil, pb.ErrInvalidArgument()
+	}
 
-	conditions := make(deviceutil.Conditions, 0)
-	conditions = append(conditions, deviceutil.EqQuery(Owner, authUser.ID))
-	conditions = append(conditions, deviceutil.WildcardQuery(SubscribePath, subscribe.Endpoint))
-	data, err := s.getEntitiesByConditions(conditions, authUser.Token, authUser.Auth, &page)
+	var (
+		data  []*pb.Entity
+		kept  []model.SubscribeEntities
+		total int64
+	)
+	if page.KeyWords != "" || req.Status != "" {
+		data, kept, total, err = s.filterSubscribedEntities(subscribe.ID, page, req.Status, authUser.Token, authUser.Auth)
+	} else {
+		var members []model.SubscribeEntities
+		if members, total, err = model.ListSubscribedEntities(subscribe.ID, page); err == nil {
+			data, kept, err = s.describeMembers(members, "", "", authUser.Token, authUser.Auth)
+		}
+	}
 	if err != nil {
 		log.Error("err:", err)
-		if errors.Is(err, ErrDeviceNotFound) {
-			return nil, pb.ErrDeviceNotFound()
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, pb.ErrInvalidArgument()
 		}
 		return nil, pb.ErrInternalQuery()
 	}
+	if n := len(kept); n != 0 {
+		page.SetNext(pagination.TimeCursor(kept[n-1].CreatedAt, kept[n-1].EntityID), n)
+	}
 
+	page.SetTotal(uint(total))
 	resp := &pb.ListSubscribeEntitiesResponse{}
 	err = page.FillResponse(resp)
 	if err != nil {
@@ -254,28 +631,27 @@ func (s *SubscribeService) ListSubscribeEntities(ctx context.Context, req *pb.Li
 	return resp, nil
 }
 
-func (s *SubscribeService) CreateSubscribe(ctx context.Context, req *pb.CreateSubscribeRequest) (*pb.CreateSubscribeResponse, error) {
+func (s *SubscribeService) CreateSubscribe(ctx context.Context, req *pb.CreateSubscribeRequest) (_ *pb.CreateSubscribeResponse, err error) {
 	authUser, err := auth.GetUser(ctx)
 	if nil != err {
 		log.Error("get auth user err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	if strings.Contains(req.Title, "@") ||
-		strings.Contains(req.Title, ",") {
-		err = errors.New("title contain illegal characters")
-		log.Error("err:", err)
-		return nil, pb.ErrUnauthenticated()
+	rec := newAuditRecord(authUser, model.AuditCreateSubscribe, 0)
+	defer func() { rec.record(err) }()
+	if err = model.CheckSubscribeQuota(authUser.Tenant, authUser.ID); err != nil {
+		return nil, quotaError(err)
 	}
 	sub := model.Subscribe{
+		TenantID:    authUser.Tenant,
 		UserID:      authUser.ID,
 		Title:       req.Title,
 		Description: req.Description,
 	}
 
-	// TODO: lock the table
 	var count string
 	findResult := model.DB().Model(&model.Subscribe{}).Select("1").
-		Where(&model.Subscribe{UserID: authUser.ID, IsDefault: true}).
+		Where(&model.Subscribe{TenantID: authUser.Tenant, UserID: authUser.ID, IsDefault: true}).
 		Limit(1).
 		Find(&count)
 	if errors.Is(
@@ -285,14 +661,20 @@ func (s *SubscribeService) CreateSubscribe(ctx context.Context, req *pb.CreateSu
 		sub.IsDefault = true
 	}
 
-	if err = model.DB().Create(&sub).Error; err != nil {
+	err = model.DB().Create(&sub).Error
+	if sub.IsDefault && errors.Is(err, model.ErrDuplicateKey) {
+		// a concurrent request has created the default subscribe
+		sub.ID, sub.IsDefault, sub.DefaultOwner = 0, false, nil
+		err = model.DB().Create(&sub).Error
+	}
+	if err != nil {
 		log.Error("err:", err)
-		mysqlErr, ok := err.(*mysql.MySQLError)
-		if ok && mysqlErr.Number == 1062 {
+		if errors.Is(err, model.ErrDuplicateKey) {
 			return nil, pb.ErrDuplicateCreate()
 		}
 		return nil, pb.ErrInternalError()
 	}
+	rec.subscribeID, rec.after = sub.ID, snapshotSubscribe(&sub)
 
 	return &pb.CreateSubscribeResponse{
 		Id:          uint64(sub.ID),
@@ -303,25 +685,28 @@ func (s *SubscribeService) CreateSubscribe(ctx context.Context, req *pb.CreateSu
 	}, nil
 }
 
-func (s *SubscribeService) UpdateSubscribe(ctx context.Context, req *pb.UpdateSubscribeRequest) (*pb.UpdateSubscribeResponse, error) {
+func (s *SubscribeService) UpdateSubscribe(ctx context.Context, req *pb.UpdateSubscribeRequest) (_ *pb.UpdateSubscribeResponse, err error) {
 	authUser, err := auth.GetUser(ctx)
 	if nil != err {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID}
-	validateSubscribeResult := model.DB().First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	rec := newAuditRecord(authUser, model.AuditUpdateSubscribe, uint(req.Id))
+	defer func() { rec.record(err) }()
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
 		log.Error("err:", err)
 		return nil, pb.ErrForbidden()
 	}
 
+	rec.before = snapshotSubscribe(&subscribe)
 	if subscribe.IsDefault {
 		return nil, pb.ErrDefaultSubscribeUnableToModify()
 	}
+	if subscribe.Managed {
+		return nil, pb.ErrSubscribeManaged()
+	}
 
-	oldTitle := subscribe.Title
 	subscribe.Title = req.Title
 	subscribe.Description = req.Description
 
@@ -330,13 +715,7 @@ func (s *SubscribeService) UpdateSubscribe(ctx context.Context, req *pb.UpdateSu
 		log.Error("err:", err)
 		return nil, pb.ErrInternalError()
 	}
-
-	if oldTitle != subscribe.Title {
-		err = subscribe.UpdateEndpointTitle(oldTitle, subscribe.Title)
-		if err != nil {
-			log.Error(err)
-		}
-	}
+	rec.after = snapshotSubscribe(&subscribe)
 
 	resp := &pb.UpdateSubscribeResponse{
 		Id:          uint64(subscribe.ID),
@@ -348,27 +727,28 @@ func (s *SubscribeService) UpdateSubscribe(ctx context.Context, req *pb.UpdateSu
 	return resp, nil
 }
 
-func (s *SubscribeService) DeleteSubscribe(ctx context.Context, req *pb.DeleteSubscribeRequest) (*pb.DeleteSubscribeResponse, error) {
+func (s *SubscribeService) DeleteSubscribe(ctx context.Context, req *pb.DeleteSubscribeRequest) (_ *pb.DeleteSubscribeResponse, err error) {
 	authUser, err := auth.GetUser(ctx)
 	if nil != err {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
+	rec := newAuditRecord(authUser, model.AuditDeleteSubscribe, uint(req.Id))
+	defer func() { rec.record(err) }()
 	log.Debugf("user %s starting to delete subscribe %d", authUser.ID, req.Id)
 	subscribe := model.Subscribe{}
-	validateSubscribeResult := model.DB().Model(&subscribe).
-		Where("id = ?", req.Id).
-		Where("user_id = ?", authUser.ID).
-		First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
 
+	rec.before = snapshotSubscribe(&subscribe)
 	if subscribe.IsDefault {
 		return nil, pb.ErrDefaultSubscribeUnableToModify()
 	}
+	if subscribe.Managed {
+		return nil, pb.ErrSubscribeManaged()
+	}
 
 	if err = model.DB().Delete(&subscribe).Error; err != nil {
 		if errors.Is(err, model.ErrUndeleteable) {
@@ -388,16 +768,15 @@ func (s *SubscribeService) GetSubscribe(ctx context.Context, req *pb.GetSubscrib
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID}
-	validateSubscribeResult := model.DB().First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
 
 	var count int64
-	model.DB().Model(&model.SubscribeEntities{}).Where("subscribe_id = ?", subscribe.ID).Count(&count)
+	model.DB().Model(&model.SubscribeEntities{}).
+		Where("tenant_id = ? AND subscribe_id = ?", subscribe.TenantID, subscribe.ID).Count(&count)
 
 	resp := &pb.GetSubscribeResponse{
 		Id:          uint64(subscribe.ID),
@@ -408,6 +787,8 @@ func (s *SubscribeService) GetSubscribe(ctx context.Context, req *pb.GetSubscrib
 		CreatedAt:   subscribe.CreatedAt.Unix(),
 		UpdatedAt:   subscribe.UpdatedAt.Unix(),
 		IsDefault:   subscribe.IsDefault,
+		Managed:     subscribe.Managed,
+		Status:      subscribe.Status,
 	}
 	return resp, nil
 }
@@ -424,15 +805,13 @@ func (s *SubscribeService) ListSubscribe(ctx context.Context, req *pb.ListSubscr
 		return nil, pb.ErrInvalidArgument()
 	}
 	var subscribes []model.Subscribe
-	result := &gorm.DB{Error: errors.New("db query error")}
-	subscribeCondition := model.Subscribe{UserID: authUser.ID}
-	if page.Required() {
-		result = model.Paginate(&subscribes, page, &subscribeCondition)
-	} else {
-		result = model.ListAll(&subscribes, &subscribeCondition)
-	}
+	subscribeCondition := model.Subscribe{TenantID: authUser.Tenant, UserID: authUser.ID}
+	result := model.Paginate(&subscribes, page, model.SubscribePageFields, &subscribeCondition)
 	if result.Error != nil {
 		log.Error("err:", result.Error)
+		if errors.Is(result.Error, model.ErrInvalidPageField) {
+			return nil, pb.ErrInvalidArgument()
+		}
 		return nil, pb.ErrInternalError()
 	}
 
@@ -444,32 +823,35 @@ func (s *SubscribeService) ListSubscribe(ctx context.Context, req *pb.ListSubscr
 			Description: subscribes[i].Description,
 			Endpoint:    model.AMQPAddressString(subscribes[i].Endpoint),
 			IsDefault:   subscribes[i].IsDefault,
+			Managed:     subscribes[i].Managed,
+			Status:      subscribes[i].Status,
 		})
 	}
 
 	resp := &pb.ListSubscribeResponse{}
-	// TODO: for template create default subscribe
-	if len(subscribes) == 0 {
-		createRequest := &pb.CreateSubscribeRequest{
-			Title:       _DefaultSubscribeTitle,
-			Description: _DefaultSubscribeDescription,
-		}
-		subscribeResponse, err := s.CreateSubscribe(ctx, createRequest)
+	if n := len(subscribes); n != 0 {
+		model.SetNextPage(&page, n, subscribes[n-1].ID)
+	}
+	// TenantEnable provisions the default subscribe of the tenant admin only, the other users, and the admin
+	// of a tenant enabled without extra.user_id, get theirs on their first ListSubscribe
+	if len(subscribes) == 0 && page.KeyWords == "" && page.Cursor == nil {
+		defaultSubscribe, _, err := model.EnsureDefaultSubscribe(authUser.Tenant, authUser.ID, _DefaultSubscribeTitle, _DefaultSubscribeDescription)
 		if err != nil {
 			log.Error("create default subscribe failed:", err)
 			return nil, pb.ErrInternalError()
 		}
 		data = append(data, &pb.SubscribeObject{
-			Id:          subscribeResponse.Id,
-			Title:       subscribeResponse.Title,
-			Description: subscribeResponse.Description,
-			Endpoint:    model.AMQPAddressString(subscribeResponse.Endpoint),
-			IsDefault:   subscribeResponse.IsDefault,
+			Id:          uint64(defaultSubscribe.ID),
+			Title:       defaultSubscribe.Title,
+			Description: defaultSubscribe.Description,
+			Endpoint:    model.AMQPAddressString(defaultSubscribe.Endpoint),
+			IsDefault:   defaultSubscribe.IsDefault,
+			Status:      defaultSubscribe.Status,
 		})
 	}
 
 	var count int64
-	if err = model.Count(&count, &subscribeCondition, &subscribeCondition).Error; err != nil {
+	if err = model.CountPage(&count, &subscribeCondition, page, model.SubscribePageFields, &subscribeCondition); err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			log.Error("err:", err)
 			return nil, err
@@ -487,75 +869,127 @@ func (s *SubscribeService) ListSubscribe(ctx context.Context, req *pb.ListSubscr
 	return resp, nil
 }
 
-func (s *SubscribeService) ChangeSubscribed(ctx context.Context, req *pb.ChangeSubscribedRequest) (*pb.ChangeSubscribedResponse, error) {
+func (s *SubscribeService) ChangeSubscribed(ctx context.Context, req *pb.ChangeSubscribedRequest) (_ *pb.ChangeSubscribedResponse, err error) {
 	authUser, err := auth.GetUser(ctx)
 	if nil != err {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
+	rec := newAuditRecord(authUser, model.AuditChangeSubscribed, uint(req.Id))
+	rec.entityIDs = req.SelectedIds
+	rec.before = map[string]uint64{"subscribe_id": req.Id}
+	rec.after = map[string]interface{}{"subscribe_id": req.TargetId, "mode": req.Mode}
+	defer func() {
+		// a dry run changes nothing
+		if !req.DryRun {
+			rec.record(err)
+		}
+	}()
 	if len(req.SelectedIds) == 0 {
 		return nil, pb.ErrInvalidArgumentSomeFields()
 	}
 
-	if req.TargetId == 0 {
+	if req.TargetId == 0 || req.TargetId == req.Id {
+		return nil, pb.ErrInvalidArgumentSomeFields()
+	}
+	mode := req.Mode
+	if mode == "" {
+		mode = model.ChangeMove
+	}
+	if mode != model.ChangeMove && mode != model.ChangeCopy {
+		err = errors.Errorf("unknown mode %q", req.Mode)
+		log.Error("err:", err)
 		return nil, pb.ErrInvalidArgumentSomeFields()
 	}
 
-	subscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.Id)}, UserID: authUser.ID}
-	validateSubscribeResult := model.DB().First(&subscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	targetSubscribe := model.Subscribe{Model: gorm.Model{ID: uint(req.TargetId)}, UserID: authUser.ID}
-	validateSubscribeResult = model.DB().First(&targetSubscribe)
-	if validateSubscribeResult.RowsAffected == 0 {
-		err = errors.Wrap(validateSubscribeResult.Error, "subscribe and user ID mismatch")
+	targetSubscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&targetSubscribe, authUser.Tenant, authUser.ID, uint(req.TargetId)); err != nil {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
+	// copying leaves the source as it is
+	if targetSubscribe.Managed || (subscribe.Managed && mode == model.ChangeMove) {
+		return nil, pb.ErrSubscribeManaged()
+	}
 
-	errs := []error{}
-	for i := range req.SelectedIds {
-		entityID := req.SelectedIds[i]
-		subscribeEntity := model.SubscribeEntities{
-			SubscribeID: subscribe.ID,
-			Subscribe:   subscribe,
-			EntityID:    entityID,
-			UniqueKey:   subscribeuril.GenerateSubscribeTopic(subscribe.ID, entityID),
-		}
-		targetSubscribeEntity := model.SubscribeEntities{
-			Subscribe:   targetSubscribe,
-			SubscribeID: targetSubscribe.ID,
-			EntityID:    entityID,
-			UniqueKey:   subscribeuril.GenerateSubscribeTopic(targetSubscribe.ID, entityID),
-		}
-		if err := model.DB().Debug().Where(targetSubscribeEntity).First(&targetSubscribeEntity).Error; !errors.Is(err, gorm.ErrRecordNotFound) {
-			errs = append(errs, errors.New("target subscribe entity already exists"))
-			_ = model.DB().Delete(&subscribeEntity)
-			continue
+	// a move keeps the entities of the tenant, only the quota of the target applies
+	checkQuota, projectQuota := model.CheckEntitiesQuota, model.ProjectEntitiesQuota
+	if mode == model.ChangeMove {
+		checkQuota, projectQuota = model.CheckMovedEntitiesQuota, model.ProjectMovedEntitiesQuota
+	}
+	if req.DryRun {
+		results, err := model.PlanChangeSubscribed(&subscribe, &targetSubscribe, req.SelectedIds, mode)
+		if err != nil {
+			log.Error("err:", err)
+			return nil, pb.ErrInternalQuery()
 		}
-		if err = model.DB().Debug().Model(&subscribeEntity).Where(subscribeEntity).Updates(targetSubscribeEntity).Error; err != nil {
-			errs = append(errs, err)
+		projection, err := projectQuota(&targetSubscribe, req.SelectedIds)
+		if err != nil {
+			log.Error("err:", err)
+			return nil, pb.ErrInternalQuery()
 		}
+		return &pb.ChangeSubscribedResponse{
+			Status:  changeStatus(results),
+			Results: entityResults(targetSubscribe.ID, results),
+			DryRun:  true,
+			Quota:   quotaProjection(projection),
+		}, nil
+	}
+	if err = checkQuota(&targetSubscribe, req.SelectedIds); err != nil {
+		return nil, quotaError(err)
 	}
 
-	if len(errs) == len(req.SelectedIds) && len(errs) > 0 {
-		err = errors.Wrap(errs[0], "change subscribed failed")
+	results, err := model.ChangeSubscribed(&subscribe, &targetSubscribe, req.SelectedIds, mode, req.AllOrNothing)
+	if errors.Is(err, model.ErrChangeIncomplete) {
 		log.Error("err:", err)
-		log.Error("more err form db process:", errs)
-		//		return nil, pb.ErrInternalError()
+		// nothing is changed, report why with the plan of the change
+		if results, err = model.PlanChangeSubscribed(&subscribe, &targetSubscribe, req.SelectedIds, mode); err != nil {
+			log.Error("err:", err)
+			return nil, pb.ErrInternalQuery()
+		}
+		for i := range results {
+			if results[i].Result != model.ResultNotSubscribed {
+				results[i] = model.EntityResult{EntityID: results[i].EntityID, Result: model.ResultFailed, Reason: "not changed, some entities cannot be changed"}
+			}
+		}
+	} else if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
 	}
 
-	resp := &pb.ChangeSubscribedResponse{Status: SuccessStatus}
-	if len(errs) != 0 {
-		resp.Status = ErrPartialFailure
+	resp := &pb.ChangeSubscribedResponse{
+		Status:  changeStatus(results),
+		Results: entityResults(targetSubscribe.ID, results),
 	}
-
+	rec.err = statusError(resp.Status)
 	return resp, nil
 }
 
+// changeStatus returns SuccessStatus when every entity of results is changed or already in the target subscription,
+// ErrFailure when none is and ErrPartialFailure otherwise.
+func changeStatus(results []model.EntityResult) string {
+	changed := 0
+	for _, result := range results {
+		switch result.Result {
+		case model.ResultMoved, model.ResultCopied, model.ResultExisting:
+			changed++
+		}
+	}
+	switch changed {
+	case len(results):
+		return SuccessStatus
+	case 0:
+		return ErrFailure
+	default:
+		return ErrPartialFailure
+	}
+}
+
 func (s *SubscribeService) ValidateSubscribed(ctx context.Context, req *pb.ValidateSubscribedRequest) (*pb.ValidateSubscribedResponse, error) {
 	authUser, err := auth.GetUser(ctx)
 	if nil != err {
@@ -566,7 +1000,7 @@ func (s *SubscribeService) ValidateSubscribed(ctx context.Context, req *pb.Valid
 		return nil, pb.ErrInvalidArgumentSomeFields()
 	}
 
-	subscribe := model.Subscribe{Endpoint: req.Topic, UserID: authUser.ID}
+	subscribe := model.Subscribe{Endpoint: req.Topic, TenantID: authUser.Tenant, UserID: authUser.ID}
 	var find model.Subscribe
 	validateSubscribeResult := model.DB().Debug().Model(&subscribe).Select("id").Where(&subscribe).Find(&find)
 	if validateSubscribeResult.RowsAffected == 0 || validateSubscribeResult.Error != nil {
@@ -579,12 +1013,28 @@ func (s *SubscribeService) ValidateSubscribed(ctx context.Context, req *pb.Valid
 	return resp, nil
 }
 
-func (s *SubscribeService) SubscribeByDevice(ctx context.Context, req *pb.SubscribeByDeviceRequest) (*pb.SubscribeByDeviceResponse, error) {
+func (s *SubscribeService) SubscribeByDevice(ctx context.Context, req *pb.SubscribeByDeviceRequest) (_ *pb.SubscribeByDeviceResponse, err error) {
 	authUser, err := auth.GetUser(ctx)
 	if nil != err {
 		log.Error("err:", err)
 		return nil, err
 	}
+	subIDs := make([]uint, 0, len(req.SubscribeIds))
+	rec := newAuditRecord(authUser, model.AuditSubscribeByDevice, 0)
+	rec.entityIDs = []string{req.Id}
+	rec.after = map[string][]string{"subscribe_ids": req.SubscribeIds}
+	defer func() {
+		if len(subIDs) == 0 {
+			rec.record(err)
+			return
+		}
+		// one event per subscription, so they are found by subscription
+		for _, id := range subIDs {
+			subscribeRec := *rec
+			subscribeRec.subscribeID = id
+			subscribeRec.record(err)
+		}
+	}()
 	if req.Id == "" {
 		return nil, pb.ErrInvalidArgumentSomeFields()
 	}
@@ -592,7 +1042,6 @@ func (s *SubscribeService) SubscribeByDevice(ctx context.Context, req *pb.Subscr
 		return nil, errors.New("invalid subscribe ids")
 	}
 
-	subIDs := make([]uint, 0, len(req.SubscribeIds))
 	for _, v := range req.SubscribeIds {
 		if i, err := strconv.Atoi(v); err != nil {
 			log.Error("err:", err)
@@ -603,44 +1052,385 @@ func (s *SubscribeService) SubscribeByDevice(ctx context.Context, req *pb.Subscr
 	}
 
 	var find []model.Subscribe
-	validateSubscribeResult := model.DB().Model(&model.Subscribe{}).
-		Select("id").
-		Where("id IN ?", req.SubscribeIds).
-		Where("user_id = ?", authUser.ID).Find(&find)
-	if validateSubscribeResult.RowsAffected != int64(len(req.SubscribeIds)) {
-		err = errors.Wrap(validateSubscribeResult.Error, "device and user mismatch")
+	if err = model.DB().
+		Where("id IN ?", subIDs).
+		Where("tenant_id = ?", authUser.Tenant).
+		Where("user_id = ?", authUser.ID).Find(&find).Error; err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	// the managed subscribes are left to their manifests
+	owned := make(map[uint]*model.Subscribe, len(find))
+	managed := make(map[uint]bool)
+	unmanaged := make([]model.Subscribe, 0, len(find))
+	for i := range find {
+		if find[i].Managed {
+			managed[find[i].ID] = true
+			continue
+		}
+		owned[find[i].ID] = &find[i]
+		unmanaged = append(unmanaged, find[i])
+	}
+	find = unmanaged
+
+	var skipped []model.EntityResult
+	if len(find) != 0 {
+		_, skipped, err = s.lookupEntities([]string{req.Id}, authUser.ID, authUser.Token, authUser.Auth)
+		if err != nil {
+			log.Error("err:", err)
+			return nil, pb.ErrInternalQuery()
+		}
+		if len(skipped) == 0 {
+			for i := range find {
+				if err = model.CheckEntitiesQuota(&find[i], []string{req.Id}); err != nil {
+					return nil, quotaError(err)
+				}
+			}
+		}
+	}
+
+	// the device is counted once per subscribe
+	summary := model.BulkSummary{}
+	resp := &pb.SubscribeByDeviceResponse{Results: make([]*pb.EntityResult, 0, len(subIDs))}
+	seen := make(map[uint]bool, len(subIDs))
+	for _, id := range subIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		result := model.EntityResult{EntityID: req.Id, Result: model.ResultForbidden, Reason: "subscribe and user mismatch"}
+		if managed[id] {
+			result.Reason = model.ErrManaged.Error()
+		} else if subscribe, ok := owned[id]; ok && len(skipped) != 0 {
+			result = skipped[0]
+		} else if ok {
+			_, results, subscribeErr := model.SubscribeEntitiesInBatches(subscribe, []string{req.Id}, 1, nil)
+			if subscribeErr != nil {
+				log.Error("err:", subscribeErr)
+			}
+			result = results[0]
+		}
+		summary.Total++
+		summary.Add(result)
+		resp.Results = append(resp.Results, entityResults(id, []model.EntityResult{result})...)
+	}
+
+	resp.Summary = bulkSubscribeSummary(summary)
+	resp.Status = SuccessStatus
+	if !summary.Succeeded() {
+		resp.Status = ErrPartialFailure
+	}
+	rec.err = statusError(resp.Status)
+	return resp, nil
+}
+
+// ReplaceSubscribeByDevice makes the device subscribed, among the subscribes of the user, by exactly the requested
+// subscribes: it is added to the missing ones and removed from the others, in one transaction.
+func (s *SubscribeService) ReplaceSubscribeByDevice(ctx context.Context, req *pb.ReplaceSubscribeByDeviceRequest) (_ *pb.ReplaceSubscribeByDeviceResponse, err error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
 		log.Error("err:", err)
 		return nil, pb.ErrUnauthenticated()
 	}
-	//	subscribeEntities := make([]model.SubscribeEntities, len(req.SubscribeIds))
-	for i := range subIDs {
-		subscribeEntity := model.SubscribeEntities{
-			SubscribeID: subIDs[i],
-			EntityID:    req.Id,
-			UniqueKey:   subscribeuril.GenerateSubscribeTopic(subIDs[i], req.Id),
+	rec := newAuditRecord(authUser, model.AuditSubscribeByDevice, 0)
+	rec.entityIDs = []string{req.Id}
+	var changed []uint
+	defer func() {
+		if len(changed) == 0 {
+			if err != nil {
+				rec.record(err)
+			}
+			return
 		}
-		if err = model.DB().Debug().Create(&subscribeEntity).Error; err != nil {
-			log.Error("create err:", err)
-			//	return nil, pb.ErrInternalError()
+		// one event per changed subscription, so they are found by subscription
+		for _, id := range changed {
+			subscribeRec := *rec
+			subscribeRec.subscribeID = id
+			subscribeRec.record(err)
 		}
+	}()
+	if req.Id == "" {
+		return nil, pb.ErrInvalidArgumentSomeFields()
+	}
+	subIDs := make([]uint, 0, len(req.SubscribeIds))
+	for _, v := range req.SubscribeIds {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			log.Error("err:", err)
+			return nil, pb.ErrInvalidArgumentSomeFields()
+		}
+		subIDs = append(subIDs, uint(i))
 	}
 
-	resp := &pb.SubscribeByDeviceResponse{Status: SuccessStatus}
+	var wanted []model.Subscribe
+	if err = model.DB().
+		Where("id IN ?", subIDs).
+		Where("tenant_id = ?", authUser.Tenant).
+		Where("user_id = ?", authUser.ID).Find(&wanted).Error; err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	current, err := model.ListSubscribesByEntity(authUser.Tenant, authUser.ID, req.Id)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalQuery()
+	}
+	has := make(map[uint]bool, len(current))
+	for i := range current {
+		has[current[i].ID] = true
+	}
+	owned := make(map[uint]bool, len(wanted)+len(current))
+	managed := make(map[uint]bool)
+	for _, sub := range append(append([]model.Subscribe{}, wanted...), current...) {
+		owned[sub.ID] = true
+		if sub.Managed {
+			managed[sub.ID] = true
+		}
+	}
+	requested := make(map[uint]bool, len(subIDs))
+	for _, id := range subIDs {
+		requested[id] = true
+	}
+
+	// the managed subscribes keep the device as they have it, asking to change them is forbidden
+	resp := &pb.ReplaceSubscribeByDeviceResponse{Status: SuccessStatus, Results: make([]*pb.EntityResult, 0, len(subIDs))}
+	replacing := make([]model.Subscribe, 0, len(wanted)+len(current))
+	for i := range wanted {
+		if !wanted[i].Managed {
+			replacing = append(replacing, wanted[i])
+		} else if !has[wanted[i].ID] {
+			resp.Results = append(resp.Results, entityResults(wanted[i].ID, []model.EntityResult{{EntityID: req.Id, Result: model.ResultForbidden, Reason: model.ErrManaged.Error()}})...)
+		}
+	}
+	for i := range current {
+		if !current[i].Managed {
+			continue
+		}
+		replacing = append(replacing, current[i])
+		if !requested[current[i].ID] {
+			resp.Results = append(resp.Results, entityResults(current[i].ID, []model.EntityResult{{EntityID: req.Id, Result: model.ResultForbidden, Reason: model.ErrManaged.Error()}})...)
+		}
+	}
+	wanted = replacing
+
+	// the device is looked up and the quotas checked only when it is added to some subscribe
+	adding := make([]model.Subscribe, 0, len(wanted))
+	for i := range wanted {
+		if !has[wanted[i].ID] {
+			adding = append(adding, wanted[i])
+		}
+	}
+	if len(adding) != 0 {
+		var skipped []model.EntityResult
+		if _, skipped, err = s.lookupEntities([]string{req.Id}, authUser.ID, authUser.Token, authUser.Auth); err != nil {
+			log.Error("err:", err)
+			return nil, pb.ErrInternalQuery()
+		}
+		if len(skipped) != 0 {
+			keeping := make([]model.Subscribe, 0, len(wanted))
+			for i := range wanted {
+				if has[wanted[i].ID] {
+					keeping = append(keeping, wanted[i])
+				} else {
+					resp.Results = append(resp.Results, entityResults(wanted[i].ID, skipped)...)
+				}
+			}
+			wanted = keeping
+		} else {
+			for i := range adding {
+				if err = model.CheckEntitiesQuota(&adding[i], []string{req.Id}); err != nil {
+					return nil, quotaError(err)
+				}
+			}
+		}
+	}
+
+	rec.before = map[string][]uint{"subscribe_ids": subscribeIDs(current)}
+	rec.after = map[string][]uint{"subscribe_ids": subscribeIDs(wanted)}
+	added, kept, removed, err := model.ReplaceEntitySubscribes(authUser.Tenant, authUser.ID, req.Id, wanted)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+
+	for _, changes := range []struct {
+		ids    []uint
+		result string
+	}{{added, model.ResultCreated}, {kept, model.ResultExisting}, {removed, model.ResultRemoved}} {
+		for _, id := range changes.ids {
+			if managed[id] && !requested[id] {
+				continue
+			}
+			resp.Results = append(resp.Results, entityResults(id, []model.EntityResult{{EntityID: req.Id, Result: changes.result}})...)
+		}
+	}
+	seen := make(map[uint]bool, len(subIDs))
+	for _, id := range subIDs {
+		if seen[id] || owned[id] {
+			continue
+		}
+		seen[id] = true
+		resp.Results = append(resp.Results, entityResults(id, []model.EntityResult{{EntityID: req.Id, Result: model.ResultForbidden, Reason: "subscribe and user mismatch"}})...)
+	}
+	for _, result := range resp.Results {
+		switch result.Result {
+		case model.ResultCreated, model.ResultExisting, model.ResultRemoved:
+		default:
+			resp.Status = ErrPartialFailure
+		}
+	}
+	changed = append(append(changed, added...), removed...)
+	rec.err = statusError(resp.Status)
 	return resp, nil
 }
 
-// createSubscribeEntitiesRecords create SubscribeEntities(subscribe_entities table) records.
-func (s *SubscribeService) createSubscribeEntitiesRecords(entityIDs []string, subscribe *model.Subscribe) []*model.SubscribeEntities {
-	records := make([]*model.SubscribeEntities, 0, len(entityIDs))
-	for _, entityID := range entityIDs {
-		subscribeEntity := model.SubscribeEntities{
-			Subscribe: *subscribe,
-			EntityID:  entityID,
-			UniqueKey: subscribeuril.GenerateSubscribeTopic(subscribe.ID, entityID),
+func (s *SubscribeService) ListSubscriptionsByEntity(ctx context.Context, req *pb.ListSubscriptionsByEntityRequest) (*pb.ListSubscriptionsByEntityResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	if req.Id == "" {
+		return nil, pb.ErrInvalidArgumentSomeFields()
+	}
+	subscribes, err := model.ListSubscribesByEntity(authUser.Tenant, authUser.ID, req.Id)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalQuery()
+	}
+	data := make([]*pb.SubscribeObject, 0, len(subscribes))
+	for i := range subscribes {
+		data = append(data, &pb.SubscribeObject{
+			Id:          uint64(subscribes[i].ID),
+			Title:       subscribes[i].Title,
+			Description: subscribes[i].Description,
+			Endpoint:    model.AMQPAddressString(subscribes[i].Endpoint),
+			IsDefault:   subscribes[i].IsDefault,
+			Managed:     subscribes[i].Managed,
+			Status:      subscribes[i].Status,
+		})
+	}
+	return &pb.ListSubscriptionsByEntityResponse{Data: data}, nil
+}
+
+func subscribeIDs(subscribes []model.Subscribe) []uint {
+	ids := make([]uint, 0, len(subscribes))
+	for i := range subscribes {
+		ids = append(ids, subscribes[i].ID)
+	}
+	return ids
+}
+
+func (s *SubscribeService) GetSubscribeProgress(ctx context.Context, req *pb.GetSubscribeProgressRequest) (*pb.GetSubscribeProgressResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+
+	var count int64
+	if err = model.DB().Model(&model.SubscribeEntities{}).
+		Where("tenant_id = ? AND subscribe_id = ?", subscribe.TenantID, subscribe.ID).Count(&count).Error; err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	counts, err := model.SubscribeOperationCounts(subscribe.ID)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	return &pb.GetSubscribeProgressResponse{
+		Id:       uint64(subscribe.ID),
+		Entities: uint64(count),
+		Pending:  uint64(counts.Pending),
+		Done:     uint64(counts.Done),
+		Failed:   uint64(counts.Failed),
+	}, nil
+}
+
+func (s *SubscribeService) ListSubscribeOperations(ctx context.Context, req *pb.ListSubscribeOperationsRequest) (*pb.ListSubscribeOperationsResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	subscribe := model.Subscribe{}
+	if err = model.FindUserSubscribe(&subscribe, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	page, err := pagination.Parse(req)
+	if err != nil {
+		log.Error("parse request page info error:", err)
+		return nil, pb.ErrInvalidArgument()
+	}
+
+	var operations []model.Outbox
+	condition := model.Outbox{SubscribeID: subscribe.ID, Status: req.Status}
+	result := model.Paginate(&operations, page, model.OutboxPageFields, &condition)
+	if result.Error != nil {
+		log.Error("err:", result.Error)
+		if errors.Is(result.Error, model.ErrInvalidPageField) {
+			return nil, pb.ErrInvalidArgument()
 		}
-		records = append(records, &subscribeEntity)
+		return nil, pb.ErrInternalError()
+	}
+	if n := len(operations); n != 0 {
+		model.SetNextPage(&page, n, operations[n-1].ID)
 	}
-	return records
+
+	var count int64
+	if err = model.CountPage(&count, &model.Outbox{}, page, model.OutboxPageFields, &condition); err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	page.SetTotal(uint(count))
+	resp := &pb.ListSubscribeOperationsResponse{}
+	if err = page.FillResponse(resp); err != nil {
+		log.Error("err:", err)
+		return nil, err
+	}
+
+	resp.Data = make([]*pb.SubscribeOperation, 0, len(operations))
+	for i := range operations {
+		resp.Data = append(resp.Data, &pb.SubscribeOperation{
+			Id:        uint64(operations[i].ID),
+			EntityId:  operations[i].EntityID,
+			Operation: operations[i].Operation,
+			Status:    operations[i].Status,
+			Attempts:  int32(operations[i].Attempts),
+			LastError: operations[i].LastError,
+			CreatedAt: operations[i].CreatedAt.Unix(),
+			UpdatedAt: operations[i].UpdatedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *SubscribeService) GetQuota(ctx context.Context, req *pb.GetQuotaRequest) (*pb.GetQuotaResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	usage, err := model.GetQuotaUsage(authUser.Tenant, authUser.ID)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	quota := model.CurrentQuota()
+	return &pb.GetQuotaResponse{
+		Subscribes:                uint64(usage.Subscribes),
+		SubscribesLimit:           uint64(quota.SubscribesPerUser),
+		EntitiesPerSubscribeLimit: uint64(quota.EntitiesPerSubscribe),
+		TenantEntities:            uint64(usage.TenantEntities),
+		TenantEntitiesLimit:       uint64(quota.EntitiesPerTenant),
+	}, nil
 }
 
 func (s *SubscribeService) getDeviceEntitiesIDsFromGroups(ctx context.Context, groups []string, token, auth string) ([]string, error) {
@@ -688,6 +1478,74 @@ func (s *SubscribeService) getDeviceEntitiesIDsFromTemplates(ctx context.Context
 	return data, nil
 }
 
+// searchDevices returns all the devices matching the conditions and the keywords of query, page by page.
+func (s *SubscribeService) searchDevices(conditions deviceutil.Conditions, query, token, auth string) ([]deviceutil.Object, error) {
+	const searchPageSize = 1000
+	client := deviceutil.NewClient(token, auth)
+	devices := make([]deviceutil.Object, 0)
+	for page := int32(1); ; page++ {
+		bytes, err := client.Search(deviceutil.DeviceSearch, conditions, deviceutil.WithPagination(page, searchPageSize), deviceutil.WithQuery(query))
+		if err != nil {
+			return nil, errors.Wrap(err, "search devices")
+		}
+		resp, err := deviceutil.ParseSearchResponse(bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse device search response")
+		}
+		list := resp.Data.ListDeviceObject
+		devices = append(devices, list.Items...)
+		if len(list.Items) < searchPageSize || int32(len(devices)) >= list.Total {
+			return devices, nil
+		}
+	}
+}
+
+// lookupEntities splits the entities into those the user owns in core and the results of the others,
+// which are not found or owned by another user.
+func (s SubscribeService) lookupEntities(ids []string, userID, token, auth string) ([]string, []model.EntityResult, error) {
+	const lookupBatchSize = 100
+	client := deviceutil.NewClient(token, auth)
+	owners := make(map[string]string, len(ids))
+	for start := 0; start < len(ids); start += lookupBatchSize {
+		end := start + lookupBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batch := ids[start:end]
+		bytes, err := client.Search(deviceutil.EntitySearch, deviceutil.Conditions{deviceutil.DevicesQuery(batch[0], batch[1:]...)})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "query devices by device ids")
+		}
+		resp, err := deviceutil.ParseSearchEntityResponse(bytes)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "parse device search response")
+		}
+		for _, item := range resp.Data.Items {
+			owners[item.Id] = item.Owner
+		}
+	}
+
+	found := make([]string, 0, len(ids))
+	skipped := make([]model.EntityResult, 0)
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		owner, ok := owners[id]
+		switch {
+		case !ok:
+			skipped = append(skipped, model.EntityResult{EntityID: id, Result: model.ResultNotFound, Reason: ErrDeviceNotFound.Error()})
+		case owner != "" && owner != userID:
+			skipped = append(skipped, model.EntityResult{EntityID: id, Result: model.ResultForbidden, Reason: "device is owned by another user"})
+		default:
+			found = append(found, id)
+		}
+	}
+	return found, skipped, nil
+}
+
 func (s SubscribeService) deviceEntities(ids []string, token, auth string) ([]*pb.Entity, error) {
 	entities := make([]*pb.Entity, 0, len(ids))
 	client := deviceutil.NewClient(token, auth)
@@ -711,51 +1569,125 @@ func (s SubscribeService) deviceEntities(ids []string, token, auth string) ([]*p
 			Name:      resp.Data.Items[0].Properties.BasicInfo.Name,
 			Template:  resp.Data.Items[0].Properties.BasicInfo.TemplateName,
 			Group:     resp.Data.Items[0].Properties.BasicInfo.ParentName,
-			Status:    "offline",
+			Status:    entityOffline,
 			UpdatedAt: resp.Data.Items[0].Properties.SysField.UpdatedAt,
 		}
 		if resp.Data.Items[0].Properties.ConnectionInfo.IsOnline {
-			entity.Status = "online"
+			entity.Status = entityOnline
 		}
 		entities = append(entities, entity)
 	}
 	return entities, nil
 }
 
-func (s SubscribeService) getEntitiesByConditions(conditions deviceutil.Conditions, token, auth string, page *pagination.Page) ([]*pb.Entity, error) {
+// describeEntities searches core for the entities, in batches, and returns those matching the key words of query
+// by ID.
+func (s SubscribeService) describeEntities(ids []string, query, token, auth string) (map[string]*pb.Entity, error) {
+	const describeBatchSize = 100
 	client := deviceutil.NewClient(token, auth)
-	entities := make([]*pb.Entity, 0)
+	entities := make(map[string]*pb.Entity, len(ids))
+	for start := 0; start < len(ids); start += describeBatchSize {
+		end := start + describeBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batch := ids[start:end]
+		bytes, err := client.Search(deviceutil.EntitySearch, deviceutil.Conditions{deviceutil.DevicesQuery(batch[0], batch[1:]...)},
+			deviceutil.WithQuery(query), deviceutil.WithPagination(1, int32(len(batch))))
+		if err != nil {
+			return nil, errors.Wrap(err, "query entities by ids")
+		}
+		resp, err := deviceutil.ParseSearchEntityResponse(bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse entity search response")
+		}
+		for _, item := range resp.Data.Items {
+			entity := &pb.Entity{
+				ID:        item.Id,
+				Name:      item.Properties.BasicInfo.Name,
+				Template:  item.Properties.BasicInfo.TemplateName,
+				Group:     item.Properties.BasicInfo.ParentName,
+				Status:    entityOffline,
+				UpdatedAt: item.Properties.SysField.UpdatedAt,
+			}
+			if item.Properties.ConnectionInfo.IsOnline {
+				entity.Status = entityOnline
+			}
+			entities[item.Id] = entity
+		}
+	}
+	return entities, nil
+}
 
-	bytes, err := client.Search(deviceutil.EntitySearch, conditions,
-		deviceutil.WithQuery(page.KeyWords), deviceutil.WithPagination(int32(page.Num), int32(page.Size)))
-	if err != nil {
-		log.Error("query device by device id err:", err)
-		return nil, err
+// describeMembers describes the members of a subscription with the entities found in core and returns those
+// matching the key words of query and the status, when set, with their members. Without key words, an entity
+// not found in core anymore is kept offline.
+func (s SubscribeService) describeMembers(members []model.SubscribeEntities, query, status, token, auth string) ([]*pb.Entity, []model.SubscribeEntities, error) {
+	ids := make([]string, 0, len(members))
+	for i := range members {
+		ids = append(ids, members[i].EntityID)
 	}
-	resp, err := deviceutil.ParseSearchEntityResponse(bytes)
+	described, err := s.describeEntities(ids, query, token, auth)
 	if err != nil {
-		log.Error("parse device search response err:", err)
-		return nil, err
+		return nil, nil, err
+	}
+	data := make([]*pb.Entity, 0, len(members))
+	kept := make([]model.SubscribeEntities, 0, len(members))
+	for i := range members {
+		entity, ok := described[members[i].EntityID]
+		if !ok {
+			if query != "" {
+				continue
+			}
+			entity = &pb.Entity{ID: members[i].EntityID, Status: entityOffline}
+		}
+		if status != "" && entity.Status != status {
+			continue
+		}
+		entity.SubscribedAt = members[i].CreatedAt.Unix()
+		data = append(data, entity)
+		kept = append(kept, members[i])
 	}
-	//	if len(resp.Data.Items) == 0 {
-	//		log.Error("device not found:", conditions)
-	//		return nil, ErrDeviceNotFound
-	//	}
-	page.SetTotal(uint(resp.Data.Total))
+	return data, kept, nil
+}
 
-	for _, item := range resp.Data.Items {
-		entity := &pb.Entity{
-			ID:        item.Id,
-			Name:      item.Properties.BasicInfo.Name,
-			Template:  item.Properties.BasicInfo.TemplateName,
-			Group:     item.Properties.BasicInfo.ParentName,
-			Status:    "offline",
-			UpdatedAt: item.Properties.SysField.UpdatedAt,
+// filterSubscribedEntities returns the page of the members of the subscription matching the key words and the
+// status of the page, and the total. The members are searched in core chunk by chunk, in the order of
+// ListSubscribedEntities, until the page is filled, so a page costs the searches of the members up to it only.
+// The total is then an upper bound: the members which are not searched count in it, it is exact once the last
+// member is searched.
+func (s *SubscribeService) filterSubscribedEntities(subscribeID uint, page pagination.Page, status, token, auth string) ([]*pb.Entity, []model.SubscribeEntities, int64, error) {
+	const filterChunkSize = 100
+	skip, limit := int(page.Offset()), int(page.Limit())
+	data := make([]*pb.Entity, 0)
+	kept := make([]model.SubscribeEntities, 0)
+	var total, rejected int64
+	chunk := pagination.Page{Num: 1, Size: filterChunkSize, IsDescending: page.IsDescending, Cursor: page.Cursor}
+	for limit == 0 || len(data) < skip+limit {
+		members, count, err := model.ListSubscribedEntities(subscribeID, chunk)
+		if err != nil {
+			return nil, nil, 0, err
 		}
-		if item.Properties.ConnectionInfo.IsOnline {
-			entity.Status = "online"
+		total = count
+		matched, matchedMembers, err := s.describeMembers(members, page.KeyWords, status, token, auth)
+		if err != nil {
+			return nil, nil, 0, err
 		}
-		entities = append(entities, entity)
+		data, kept = append(data, matched...), append(kept, matchedMembers...)
+		rejected += int64(len(members) - len(matched))
+		if len(members) < filterChunkSize {
+			break
+		}
+		last := pagination.TimeCursor(members[len(members)-1].CreatedAt, members[len(members)-1].EntityID)
+		chunk.Cursor = &last
 	}
-	return entities, nil
+
+	if skip > len(data) {
+		skip = len(data)
+	}
+	data, kept = data[skip:], kept[skip:]
+	if limit != 0 && len(data) > limit {
+		data, kept = data[:limit], kept[:limit]
+	}
+	return data, kept, total - rejected, nil
 }
@@ -32,7 +32,7 @@ func TestDB(t *testing.T) {
 	}
 
 	var records []model.SubscribeEntities
-	result := model.Paginate(&records, page, model.SubscribeEntities{SubscribeID: subscribe.ID})
+	result := model.Paginate(&records, page, model.PageFields{}, model.SubscribeEntities{SubscribeID: subscribe.ID})
 	if result.Error != nil {
 		fmt.Println("err:", result.Error)
 	}
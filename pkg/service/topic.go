@@ -19,7 +19,9 @@ package service
 import (
 	"context"
 
+	"github.com/pkg/errors"
 	pb "github.com/tkeel-io/core-broker/api/topic/v1"
+	"github.com/tkeel-io/core-broker/pkg/model"
 	"github.com/tkeel-io/core-broker/pkg/types"
 	"github.com/tkeel-io/kit/log"
 )
@@ -42,7 +44,32 @@ func NewTopicService() *TopicService {
 }
 
 func (s *TopicService) TopicEventHandler(ctx context.Context, req *pb.TopicEventRequest) (*pb.TopicEventResponse, error) {
+	if req.Topic == types.FanoutTopic {
+		return s.fanout(req), nil
+	}
 	types.MsgChan <- req
 	log.Debug("topic event", req)
 	return &pb.TopicEventResponse{Status: SubscriptionResponseStatusSuccess}, nil
 }
+
+// fanout publishes the event of the shared core subscription to the endpoints of the entity.
+func (s *TopicService) fanout(req *pb.TopicEventRequest) *pb.TopicEventResponse {
+	data := req.Data.AsInterface()
+	kv, ok := data.(map[string]interface{})
+	if !ok {
+		log.Errorf("drop fanout event %s: unexpected data type %T", req.Id, data)
+		return &pb.TopicEventResponse{Status: SubscriptionResponseStatusDrop}
+	}
+	subscriptionID := types.Interface2string(kv["id"])
+	published, err := model.Fanout(subscriptionID, data)
+	if err != nil {
+		if errors.Is(err, model.ErrUnknownCoreSubscription) {
+			log.Errorf("drop fanout event %s: %v", req.Id, err)
+			return &pb.TopicEventResponse{Status: SubscriptionResponseStatusDrop}
+		}
+		log.Errorf("fanout event %s err: %v", req.Id, err)
+		return &pb.TopicEventResponse{Status: SubscriptionResponseStatusRetry}
+	}
+	log.Debugf("fanout event %s of %s to %d endpoints", req.Id, subscriptionID, published)
+	return &pb.TopicEventResponse{Status: SubscriptionResponseStatusSuccess}
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core-broker/api/subscribe/v1"
+	"github.com/tkeel-io/core-broker/pkg/auth"
+	"github.com/tkeel-io/core-broker/pkg/model"
+	"github.com/tkeel-io/core-broker/pkg/pagination"
+	"github.com/tkeel-io/kit/log"
+	"gorm.io/gorm"
+)
+
+func (s *SubscribeService) ListDeletedSubscribes(ctx context.Context, req *pb.ListDeletedSubscribesRequest) (*pb.ListDeletedSubscribesResponse, error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	page, err := pagination.Parse(req)
+	if err != nil {
+		log.Error("parse request page info error:", err)
+		return nil, pb.ErrInvalidArgument()
+	}
+
+	deleted, total, err := model.ListDeletedSubscribes(authUser.Tenant, authUser.ID, page)
+	if err != nil {
+		log.Error("err:", err)
+		if errors.Is(err, pagination.ErrInvalidPageToken) {
+			return nil, pb.ErrInvalidArgument()
+		}
+		return nil, pb.ErrInternalError()
+	}
+	if n := len(deleted); n != 0 {
+		next := pagination.TimeCursor(deleted[n-1].DeletedAt.Time, "")
+		next.ID = uint64(deleted[n-1].ID)
+		page.SetNext(next, n)
+	}
+	page.SetTotal(uint(total))
+	resp := &pb.ListDeletedSubscribesResponse{}
+	if err = page.FillResponse(resp); err != nil {
+		log.Error("err:", err)
+		return nil, err
+	}
+	resp.Data = make([]*pb.DeletedSubscribe, 0, len(deleted))
+	for i := range deleted {
+		item := &pb.DeletedSubscribe{
+			Id:          uint64(deleted[i].ID),
+			Title:       deleted[i].Title,
+			Description: deleted[i].Description,
+			Endpoint:    model.AMQPAddressString(deleted[i].Endpoint),
+			IsDefault:   deleted[i].IsDefault,
+			Entities:    uint64(deleted[i].Entities),
+			DeletedAt:   deleted[i].DeletedAt.Time.Unix(),
+		}
+		if s.retention > 0 {
+			item.PurgeAt = deleted[i].DeletedAt.Time.Add(s.retention).Unix()
+		}
+		resp.Data = append(resp.Data, item)
+	}
+	return resp, nil
+}
+
+func (s *SubscribeService) RestoreSubscribe(ctx context.Context, req *pb.RestoreSubscribeRequest) (_ *pb.RestoreSubscribeResponse, err error) {
+	authUser, err := auth.GetUser(ctx)
+	if nil != err {
+		log.Error("err:", err)
+		return nil, pb.ErrUnauthenticated()
+	}
+	rec := newAuditRecord(authUser, model.AuditRestoreSubscribe, uint(req.Id))
+	defer func() { rec.record(err) }()
+
+	deleted := model.Subscribe{}
+	if err = model.FindDeletedSubscribe(&deleted, authUser.Tenant, authUser.ID, uint(req.Id)); err != nil {
+		log.Error("err:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pb.ErrNotFound()
+		}
+		return nil, pb.ErrInternalQuery()
+	}
+	if err = model.CheckSubscribeQuota(authUser.Tenant, authUser.ID); err != nil {
+		return nil, quotaError(err)
+	}
+	entityIDs, err := model.ArchivedEntityIDs(deleted.ID)
+	if err != nil {
+		log.Error("err:", err)
+		return nil, pb.ErrInternalError()
+	}
+	rec.entityIDs = entityIDs
+	if err = model.CheckEntitiesQuota(&deleted, entityIDs); err != nil {
+		return nil, quotaError(err)
+	}
+
+	subscribe, restored, err := model.RestoreSubscribe(authUser.Tenant, authUser.ID, uint(req.Id))
+	if err != nil {
+		log.Error("err:", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pb.ErrNotFound()
+		}
+		return nil, pb.ErrInternalError()
+	}
+	rec.after = snapshotSubscribe(subscribe)
+
+	return &pb.RestoreSubscribeResponse{
+		Id:          uint64(subscribe.ID),
+		Title:       subscribe.Title,
+		Description: subscribe.Description,
+		Endpoint:    subscribe.Endpoint,
+		IsDefault:   subscribe.IsDefault,
+		Entities:    uint64(restored),
+	}, nil
+}
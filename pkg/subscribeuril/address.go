@@ -0,0 +1,82 @@
+package subscribeuril
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// AddressVersion is the version of Address written into sysField._subscribeAddr.
+const AddressVersion = 1
+
+const (
+	legacyAddressSeparator = ","
+	legacyFieldSeparator   = "@"
+)
+
+var ErrInvalidAddress = errors.New("invalid subscribe address")
+
+// Address is one element of the JSON array stored in sysField._subscribeAddr,
+// it tells downstream consumers which subscription delivers the entity and where.
+type Address struct {
+	Version     int    `json:"version"`
+	SubscribeID uint   `json:"subscribe_id"`
+	Endpoint    string `json:"endpoint"`
+}
+
+func NewAddress(subscribeID uint, endpoint string) Address {
+	return Address{
+		Version:     AddressVersion,
+		SubscribeID: subscribeID,
+		Endpoint:    endpoint,
+	}
+}
+
+// ParseAddresses parses the value of sysField._subscribeAddr,
+// both the JSON array and the legacy "title@subscribeID@endpoint,..." format are accepted.
+func ParseAddresses(s string) ([]Address, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return []Address{}, nil
+	}
+	if !IsLegacyAddresses(s) {
+		addrs := make([]Address, 0)
+		if err := json.Unmarshal([]byte(s), &addrs); err != nil {
+			return nil, errors.Wrap(ErrInvalidAddress, err.Error())
+		}
+		return addrs, nil
+	}
+
+	items := strings.Split(s, legacyAddressSeparator)
+	addrs := make([]Address, 0, len(items))
+	for _, item := range items {
+		fields := strings.SplitN(item, legacyFieldSeparator, 3)
+		if len(fields) != 3 {
+			return nil, errors.Wrapf(ErrInvalidAddress, "legacy address %q", item)
+		}
+		id, err := strconv.ParseUint(fields[1], 10, 0)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidAddress, "legacy address %q", item)
+		}
+		addrs = append(addrs, NewAddress(uint(id), fields[2]))
+	}
+	return addrs, nil
+}
+
+// FormatAddresses formats addresses as the value of sysField._subscribeAddr,
+// no addresses are formatted as an empty string.
+func FormatAddresses(addrs []Address) string {
+	if len(addrs) == 0 {
+		return ""
+	}
+	content, _ := json.Marshal(addrs)
+	return string(content)
+}
+
+// IsLegacyAddresses reports whether s is written in the "title@subscribeID@endpoint" format.
+func IsLegacyAddresses(s string) bool {
+	s = strings.TrimSpace(s)
+	return s != "" && !strings.HasPrefix(s, "[")
+}
@@ -0,0 +1,45 @@
+package subscribeuril
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAddresses(t *testing.T) {
+	tests := []struct {
+		name     string
+		addr     string
+		excepted []Address
+		hasErr   bool
+	}{
+		{"empty", "", []Address{}, false},
+		{"structured", `[{"version":1,"subscribe_id":4,"endpoint":"amqp://tkeel.io:5672/AAa1yvw7dYJGkuQU"}]`,
+			[]Address{NewAddress(4, "amqp://tkeel.io:5672/AAa1yvw7dYJGkuQU")}, false},
+		{"legacy", "Default Title@4@amqp://tkeel.io:5672/AAa1yvw7dYJGkuQU,123132@5@amqp://tkeel.io:5672/soV8UVBhdyLakMpR",
+			[]Address{NewAddress(4, "amqp://tkeel.io:5672/AAa1yvw7dYJGkuQU"), NewAddress(5, "amqp://tkeel.io:5672/soV8UVBhdyLakMpR")}, false},
+		{"legacy without id", "title@amqp://tkeel.io:5672/AAa1yvw7dYJGkuQU", nil, true},
+		{"broken json", `[{"version":1`, nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			addrs, err := ParseAddresses(test.addr)
+			if test.hasErr {
+				assert.ErrorIs(t, err, ErrInvalidAddress)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.excepted, addrs)
+		})
+	}
+}
+
+func TestFormatAddresses(t *testing.T) {
+	assert.Equal(t, "", FormatAddresses(nil))
+	addrs := []Address{NewAddress(4, "amqp://tkeel.io:5672/AAa1yvw7dYJGkuQU")}
+	s := FormatAddresses(addrs)
+	assert.Equal(t, `[{"version":1,"subscribe_id":4,"endpoint":"amqp://tkeel.io:5672/AAa1yvw7dYJGkuQU"}]`, s)
+	parsed, err := ParseAddresses(s)
+	assert.NoError(t, err)
+	assert.Equal(t, addrs, parsed)
+}
@@ -45,6 +45,10 @@ type WsRequest struct {
 
 const PubsubName = "core-broker-pubsub"
 
+// FanoutTopic receives the events of all subscribed entities from core,
+// the broker publishes them again to the endpoint of every subscription.
+const FanoutTopic = "core-broker-fanout"
+
 func SubscriptionIDByJoin(entityID, topic string) string {
 	return entityID + "_" + topic
 }